@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:generate mockgen -package "$GOPACKAGE" -destination "mock_$GOFILE" -source "$GOFILE" -typed
+
+package openai
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/isac322/terraform-provider-openaiadmin/internal/utils"
+	"github.com/openai/openai-go"
+	"github.com/pkg/errors"
+)
+
+type AdminAPIKeyService interface {
+	List(ctx context.Context) ([]AdminAPIKey, error)
+	Create(ctx context.Context, name string) (*AdminAPIKey, error)
+	Retrieve(ctx context.Context, keyID string) (*AdminAPIKey, error)
+	Delete(ctx context.Context, keyID string) error
+}
+
+// sdkAdminAPIKeyService handles operations related to organization admin API keys in the OpenAI admin API.
+type sdkAdminAPIKeyService struct {
+	client *openai.Client
+}
+
+func NewSDKAdminAPIKeyService(client *openai.Client) AdminAPIKeyService {
+	return sdkAdminAPIKeyService{client: client}
+}
+
+type AdminAPIKeyOwner struct {
+	Type      string              `json:"type"`
+	ID        string              `json:"id"`
+	Name      string              `json:"name"`
+	CreatedAt utils.UnixTimestamp `json:"created_at"`
+	Role      string              `json:"role"`
+}
+
+// AdminAPIKey represents an organization admin API key.
+// Value is only returned when the key is created.
+type AdminAPIKey struct {
+	ID            string               `json:"id"`
+	Name          string               `json:"name"`
+	RedactedValue string               `json:"redacted_value"`
+	Value         string               `json:"value,omitempty"`
+	CreatedAt     utils.UnixTimestamp  `json:"created_at"`
+	LastUsedAt    *utils.UnixTimestamp `json:"last_used_at,omitempty"`
+	Owner         AdminAPIKeyOwner     `json:"owner"`
+}
+
+type AdminAPIKeyListParams struct {
+	After *string
+	Limit *int
+}
+
+func (p AdminAPIKeyListParams) URLQuery() url.Values {
+	v := url.Values{}
+	if p.After != nil {
+		v.Set("after", *p.After)
+	}
+	if p.Limit != nil {
+		v.Set("limit", strconv.Itoa(*p.Limit))
+	}
+	return v
+}
+
+type AdminAPIKeyListResponse struct {
+	Data    []AdminAPIKey `json:"data"`
+	FirstID string        `json:"first_id"`
+	LastID  string        `json:"last_id"`
+	HasMore bool          `json:"has_more"`
+}
+
+// List retrieves all admin API keys of the organization, with optional pagination parameters.
+func (s sdkAdminAPIKeyService) List(ctx context.Context) ([]AdminAPIKey, error) {
+	var apiKeys []AdminAPIKey
+
+	limit := 100
+	params := AdminAPIKeyListParams{
+		Limit: &limit,
+	}
+
+	for {
+		var result AdminAPIKeyListResponse
+		err := s.client.Get(ctx, "/organization/admin_api_keys", params, &result)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		apiKeys = append(apiKeys, result.Data...)
+		if !result.HasMore {
+			break
+		}
+		params.After = &result.LastID
+	}
+
+	return apiKeys, nil
+}
+
+type AdminAPIKeyCreateBody struct {
+	Name string `json:"name"`
+}
+
+// Create creates a new admin API key. The returned key is the only one that contains the secret value.
+func (s sdkAdminAPIKeyService) Create(ctx context.Context, name string) (*AdminAPIKey, error) {
+	var result AdminAPIKey
+	err := s.client.Post(ctx, "/organization/admin_api_keys", AdminAPIKeyCreateBody{Name: name}, &result)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &result, nil
+}
+
+// Retrieve fetches an admin API key by its ID.
+func (s sdkAdminAPIKeyService) Retrieve(ctx context.Context, keyID string) (*AdminAPIKey, error) {
+	var result AdminAPIKey
+	err := s.client.Get(ctx, "/organization/admin_api_keys/"+keyID, nil, &result)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &result, nil
+}
+
+// Delete removes an admin API key by its ID.
+func (s sdkAdminAPIKeyService) Delete(ctx context.Context, keyID string) error {
+	err := s.client.Delete(ctx, "/organization/admin_api_keys/"+keyID, nil, nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
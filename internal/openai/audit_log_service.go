@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:generate mockgen -package "$GOPACKAGE" -destination "mock_$GOFILE" -source "$GOFILE" -typed
+
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/isac322/terraform-provider-openaiadmin/internal/utils"
+	"github.com/openai/openai-go"
+	"github.com/pkg/errors"
+)
+
+type AuditLogService interface {
+	List(ctx context.Context, filter AuditLogFilter) ([]AuditLog, error)
+}
+
+// sdkAuditLogService handles operations related to audit logs in the OpenAI admin API.
+type sdkAuditLogService struct {
+	client *openai.Client
+}
+
+func NewSDKAuditLogService(client *openai.Client) AuditLogService {
+	return sdkAuditLogService{client: client}
+}
+
+type AuditLogProject struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type AuditLogActorUser struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+}
+
+type AuditLogActorSession struct {
+	User      AuditLogActorUser `json:"user"`
+	IPAddress string            `json:"ip_address"`
+	UserAgent string            `json:"user_agent"`
+}
+
+type AuditLogActorServiceAccount struct {
+	ID string `json:"id"`
+}
+
+type AuditLogActorAPIKey struct {
+	ID             string                       `json:"id"`
+	Type           string                       `json:"type"`
+	User           *AuditLogActorUser           `json:"user,omitempty"`
+	ServiceAccount *AuditLogActorServiceAccount `json:"service_account,omitempty"`
+}
+
+// AuditLogActor is the user or API key that performed the audited action.
+type AuditLogActor struct {
+	Type    string                `json:"type"`
+	Session *AuditLogActorSession `json:"session,omitempty"`
+	APIKey  *AuditLogActorAPIKey  `json:"api_key,omitempty"`
+}
+
+// AuditLog represents an event in the organization audit log.
+// Payload holds the raw event-specific object, which the API stores under a key named after the event type.
+type AuditLog struct {
+	ID          string              `json:"id"`
+	Type        string              `json:"type"`
+	EffectiveAt utils.UnixTimestamp `json:"effective_at"`
+	Project     *AuditLogProject    `json:"project,omitempty"`
+	Actor       AuditLogActor       `json:"actor"`
+	Payload     json.RawMessage     `json:"-"`
+}
+
+func (l *AuditLog) UnmarshalJSON(data []byte) error {
+	type auditLog AuditLog
+	if err := json.Unmarshal(data, (*auditLog)(l)); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	l.Payload = fields[l.Type]
+
+	return nil
+}
+
+// AuditLogFilter represents the filters supported when listing audit logs.
+// Empty fields are not sent to the API.
+type AuditLogFilter struct {
+	EffectiveAtGt  *time.Time
+	EffectiveAtGte *time.Time
+	EffectiveAtLt  *time.Time
+	EffectiveAtLte *time.Time
+	ProjectIDs     []string
+	EventTypes     []string
+	ActorIDs       []string
+	ActorEmails    []string
+	ResourceIDs    []string
+}
+
+type AuditLogListParams struct {
+	AuditLogFilter
+	After *string
+	Limit *int
+}
+
+func (p AuditLogListParams) URLQuery() url.Values {
+	v := url.Values{}
+	if p.After != nil {
+		v.Set("after", *p.After)
+	}
+	if p.Limit != nil {
+		v.Set("limit", strconv.Itoa(*p.Limit))
+	}
+
+	setTime := func(key string, t *time.Time) {
+		if t != nil {
+			v.Set(key, strconv.FormatInt(t.Unix(), 10))
+		}
+	}
+	setTime("effective_at[gt]", p.EffectiveAtGt)
+	setTime("effective_at[gte]", p.EffectiveAtGte)
+	setTime("effective_at[lt]", p.EffectiveAtLt)
+	setTime("effective_at[lte]", p.EffectiveAtLte)
+
+	addAll := func(key string, values []string) {
+		for _, value := range values {
+			v.Add(key, value)
+		}
+	}
+	addAll("project_ids[]", p.ProjectIDs)
+	addAll("event_types[]", p.EventTypes)
+	addAll("actor_ids[]", p.ActorIDs)
+	addAll("actor_emails[]", p.ActorEmails)
+	addAll("resource_ids[]", p.ResourceIDs)
+
+	return v
+}
+
+type AuditLogListResponse struct {
+	Data    []AuditLog `json:"data"`
+	FirstID string     `json:"first_id"`
+	LastID  string     `json:"last_id"`
+	HasMore bool       `json:"has_more"`
+}
+
+// List retrieves all audit logs matching the filter, with optional pagination parameters.
+func (s sdkAuditLogService) List(ctx context.Context, filter AuditLogFilter) ([]AuditLog, error) {
+	var auditLogs []AuditLog
+
+	limit := 100
+	params := AuditLogListParams{
+		AuditLogFilter: filter,
+		Limit:          &limit,
+	}
+
+	for {
+		var result AuditLogListResponse
+		err := s.client.Get(ctx, "/organization/audit_logs", params, &result)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		auditLogs = append(auditLogs, result.Data...)
+		if !result.HasMore {
+			break
+		}
+		params.After = &result.LastID
+	}
+
+	return auditLogs, nil
+}
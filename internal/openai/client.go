@@ -10,11 +10,16 @@ import (
 )
 
 type Client struct {
+	AdminAPIKeys           AdminAPIKeyService
+	AuditLogs              AuditLogService
+	Costs                  CostService
 	Invites                InviteService
 	ProjectAPIKeys         ProjectAPIKeyService
+	ProjectRateLimits      ProjectRateLimitService
 	Projects               ProjectService
 	ProjectServiceAccounts ProjectServiceAccountService
 	ProjectUsers           ProjectUserService
+	Usage                  UsageService
 	Users                  UserService
 }
 
@@ -26,11 +31,16 @@ func NewSDKClient(apiKey string, baseURL *string) Client {
 
 	client := openai.NewClient(options...)
 	return Client{
+		AdminAPIKeys:           NewSDKAdminAPIKeyService(client),
+		AuditLogs:              NewSDKAuditLogService(client),
+		Costs:                  NewSDKCostService(client),
 		Invites:                NewSDKInviteService(client),
 		ProjectAPIKeys:         NewSDKProjectAPIKeyService(client),
+		ProjectRateLimits:      NewSDKProjectRateLimitService(client),
 		Projects:               NewSDKProjectService(client),
 		ProjectServiceAccounts: NewSDKProjectServiceAccountService(client),
 		ProjectUsers:           NewSDKProjectUserService(client),
+		Usage:                  NewSDKUsageService(client),
 		Users:                  NewSDKUserService(client),
 	}
 }
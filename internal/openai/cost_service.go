@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:generate mockgen -package "$GOPACKAGE" -destination "mock_$GOFILE" -source "$GOFILE" -typed
+
+package openai
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/isac322/terraform-provider-openaiadmin/internal/utils"
+	"github.com/openai/openai-go"
+	"github.com/pkg/errors"
+)
+
+type CostService interface {
+	List(ctx context.Context, params CostParams) ([]CostBucket, error)
+}
+
+// sdkCostService handles operations related to the organization costs API.
+type sdkCostService struct {
+	client *openai.Client
+}
+
+func NewSDKCostService(client *openai.Client) CostService {
+	return sdkCostService{client: client}
+}
+
+type CostAmount struct {
+	Value    float64 `json:"value"`
+	Currency string  `json:"currency"`
+}
+
+// CostResult is a single aggregated cost within a bucket.
+// ProjectID and LineItem are only set when the results are grouped by them.
+type CostResult struct {
+	Amount    CostAmount `json:"amount"`
+	ProjectID *string    `json:"project_id,omitempty"`
+	LineItem  *string    `json:"line_item,omitempty"`
+}
+
+type CostBucket struct {
+	StartTime utils.UnixTimestamp `json:"start_time"`
+	EndTime   utils.UnixTimestamp `json:"end_time"`
+	Results   []CostResult        `json:"results"`
+}
+
+// CostParams represents the query parameters of the costs endpoint.
+// Empty fields are not sent to the API.
+type CostParams struct {
+	StartTime   time.Time
+	EndTime     *time.Time
+	BucketWidth *string
+	ProjectIDs  []string
+	GroupBy     []string
+}
+
+type CostListParams struct {
+	CostParams
+	Page *string
+}
+
+func (p CostListParams) URLQuery() url.Values {
+	v := url.Values{}
+	v.Set("start_time", strconv.FormatInt(p.StartTime.Unix(), 10))
+	if p.EndTime != nil {
+		v.Set("end_time", strconv.FormatInt(p.EndTime.Unix(), 10))
+	}
+	if p.BucketWidth != nil {
+		v.Set("bucket_width", *p.BucketWidth)
+	}
+	if p.Page != nil {
+		v.Set("page", *p.Page)
+	}
+	for _, projectID := range p.ProjectIDs {
+		v.Add("project_ids[]", projectID)
+	}
+	for _, groupBy := range p.GroupBy {
+		v.Add("group_by[]", groupBy)
+	}
+
+	return v
+}
+
+type CostListResponse struct {
+	Data     []CostBucket `json:"data"`
+	HasMore  bool         `json:"has_more"`
+	NextPage *string      `json:"next_page"`
+}
+
+// List retrieves all cost buckets of the organization, following the next_page cursor.
+func (s sdkCostService) List(ctx context.Context, costParams CostParams) ([]CostBucket, error) {
+	var buckets []CostBucket
+
+	params := CostListParams{
+		CostParams: costParams,
+	}
+
+	for {
+		var result CostListResponse
+		err := s.client.Get(ctx, "/organization/costs", params, &result)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		buckets = append(buckets, result.Data...)
+		if !result.HasMore || result.NextPage == nil {
+			break
+		}
+		params.Page = result.NextPage
+	}
+
+	return buckets, nil
+}
@@ -17,7 +17,7 @@ import (
 
 type InviteService interface {
 	List(ctx context.Context) ([]Invite, error)
-	Create(ctx context.Context, email string, role InviteRole) (*Invite, error)
+	Create(ctx context.Context, email string, role InviteRole, projects ...InviteProject) (*Invite, error)
 	Retrieve(ctx context.Context, inviteID string) (*Invite, error)
 	Delete(ctx context.Context, inviteID string) error
 }
@@ -48,6 +48,12 @@ const (
 	InviteRoleOwner  InviteRole = "owner"
 )
 
+// InviteProject is a project membership the invitee is granted when accepting the invite.
+type InviteProject struct {
+	ID   string          `json:"id"`
+	Role ProjectUserRole `json:"role"`
+}
+
 type Invite struct {
 	ID         string               `json:"id"`
 	Email      string               `json:"email"`
@@ -56,6 +62,7 @@ type Invite struct {
 	InvitedAt  utils.UnixTimestamp  `json:"invited_at"`
 	ExpiresAt  utils.UnixTimestamp  `json:"expires_at,omitempty"`
 	AcceptedAt *utils.UnixTimestamp `json:"accepted_at,omitempty"`
+	Projects   []InviteProject      `json:"projects,omitempty"`
 }
 
 type InviteListParams struct {
@@ -107,14 +114,21 @@ func (s sdkInviteService) List(ctx context.Context) ([]Invite, error) {
 }
 
 type InviteCreateBody struct {
-	Email string     `json:"email"`
-	Role  InviteRole `json:"role"`
+	Email    string          `json:"email"`
+	Role     InviteRole      `json:"role"`
+	Projects []InviteProject `json:"projects,omitempty"`
 }
 
-// Create sends an invite to a new user.
-func (s sdkInviteService) Create(ctx context.Context, email string, role InviteRole) (*Invite, error) {
+// Create sends an invite to a new user, who joins the given projects on acceptance.
+func (s sdkInviteService) Create(
+	ctx context.Context,
+	email string,
+	role InviteRole,
+	projects ...InviteProject,
+) (*Invite, error) {
 	var result Invite
-	err := s.client.Post(ctx, "/organization/invites", InviteCreateBody{Email: email, Role: role}, &result)
+	body := InviteCreateBody{Email: email, Role: role, Projects: projects}
+	err := s.client.Post(ctx, "/organization/invites", body, &result)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -0,0 +1,196 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: admin_api_key_service.go
+//
+// Generated by this command:
+//
+//	mockgen -package openai -destination mock_admin_api_key_service.go -source admin_api_key_service.go -typed
+//
+
+// Package openai is a generated GoMock package.
+package openai
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAdminAPIKeyService is a mock of AdminAPIKeyService interface.
+type MockAdminAPIKeyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminAPIKeyServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminAPIKeyServiceMockRecorder is the mock recorder for MockAdminAPIKeyService.
+type MockAdminAPIKeyServiceMockRecorder struct {
+	mock *MockAdminAPIKeyService
+}
+
+// NewMockAdminAPIKeyService creates a new mock instance.
+func NewMockAdminAPIKeyService(ctrl *gomock.Controller) *MockAdminAPIKeyService {
+	mock := &MockAdminAPIKeyService{ctrl: ctrl}
+	mock.recorder = &MockAdminAPIKeyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminAPIKeyService) EXPECT() *MockAdminAPIKeyServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAdminAPIKeyService) Create(ctx context.Context, name string) (*AdminAPIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, name)
+	ret0, _ := ret[0].(*AdminAPIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAdminAPIKeyServiceMockRecorder) Create(ctx, name any) *MockAdminAPIKeyServiceCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAdminAPIKeyService)(nil).Create), ctx, name)
+	return &MockAdminAPIKeyServiceCreateCall{Call: call}
+}
+
+// MockAdminAPIKeyServiceCreateCall wrap *gomock.Call
+type MockAdminAPIKeyServiceCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAdminAPIKeyServiceCreateCall) Return(arg0 *AdminAPIKey, arg1 error) *MockAdminAPIKeyServiceCreateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAdminAPIKeyServiceCreateCall) Do(f func(context.Context, string) (*AdminAPIKey, error)) *MockAdminAPIKeyServiceCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAdminAPIKeyServiceCreateCall) DoAndReturn(f func(context.Context, string) (*AdminAPIKey, error)) *MockAdminAPIKeyServiceCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MockAdminAPIKeyService) Delete(ctx context.Context, keyID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, keyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAdminAPIKeyServiceMockRecorder) Delete(ctx, keyID any) *MockAdminAPIKeyServiceDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAdminAPIKeyService)(nil).Delete), ctx, keyID)
+	return &MockAdminAPIKeyServiceDeleteCall{Call: call}
+}
+
+// MockAdminAPIKeyServiceDeleteCall wrap *gomock.Call
+type MockAdminAPIKeyServiceDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAdminAPIKeyServiceDeleteCall) Return(arg0 error) *MockAdminAPIKeyServiceDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAdminAPIKeyServiceDeleteCall) Do(f func(context.Context, string) error) *MockAdminAPIKeyServiceDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAdminAPIKeyServiceDeleteCall) DoAndReturn(f func(context.Context, string) error) *MockAdminAPIKeyServiceDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockAdminAPIKeyService) List(ctx context.Context) ([]AdminAPIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]AdminAPIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAdminAPIKeyServiceMockRecorder) List(ctx any) *MockAdminAPIKeyServiceListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAdminAPIKeyService)(nil).List), ctx)
+	return &MockAdminAPIKeyServiceListCall{Call: call}
+}
+
+// MockAdminAPIKeyServiceListCall wrap *gomock.Call
+type MockAdminAPIKeyServiceListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAdminAPIKeyServiceListCall) Return(arg0 []AdminAPIKey, arg1 error) *MockAdminAPIKeyServiceListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAdminAPIKeyServiceListCall) Do(f func(context.Context) ([]AdminAPIKey, error)) *MockAdminAPIKeyServiceListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAdminAPIKeyServiceListCall) DoAndReturn(f func(context.Context) ([]AdminAPIKey, error)) *MockAdminAPIKeyServiceListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Retrieve mocks base method.
+func (m *MockAdminAPIKeyService) Retrieve(ctx context.Context, keyID string) (*AdminAPIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retrieve", ctx, keyID)
+	ret0, _ := ret[0].(*AdminAPIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Retrieve indicates an expected call of Retrieve.
+func (mr *MockAdminAPIKeyServiceMockRecorder) Retrieve(ctx, keyID any) *MockAdminAPIKeyServiceRetrieveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retrieve", reflect.TypeOf((*MockAdminAPIKeyService)(nil).Retrieve), ctx, keyID)
+	return &MockAdminAPIKeyServiceRetrieveCall{Call: call}
+}
+
+// MockAdminAPIKeyServiceRetrieveCall wrap *gomock.Call
+type MockAdminAPIKeyServiceRetrieveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAdminAPIKeyServiceRetrieveCall) Return(arg0 *AdminAPIKey, arg1 error) *MockAdminAPIKeyServiceRetrieveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAdminAPIKeyServiceRetrieveCall) Do(f func(context.Context, string) (*AdminAPIKey, error)) *MockAdminAPIKeyServiceRetrieveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAdminAPIKeyServiceRetrieveCall) DoAndReturn(f func(context.Context, string) (*AdminAPIKey, error)) *MockAdminAPIKeyServiceRetrieveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit_log_service.go
+//
+// Generated by this command:
+//
+//	mockgen -package openai -destination mock_audit_log_service.go -source audit_log_service.go -typed
+//
+
+// Package openai is a generated GoMock package.
+package openai
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuditLogService is a mock of AuditLogService interface.
+type MockAuditLogService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditLogServiceMockRecorder is the mock recorder for MockAuditLogService.
+type MockAuditLogServiceMockRecorder struct {
+	mock *MockAuditLogService
+}
+
+// NewMockAuditLogService creates a new mock instance.
+func NewMockAuditLogService(ctrl *gomock.Controller) *MockAuditLogService {
+	mock := &MockAuditLogService{ctrl: ctrl}
+	mock.recorder = &MockAuditLogServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogService) EXPECT() *MockAuditLogServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockAuditLogService) List(ctx context.Context, filter AuditLogFilter) ([]AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAuditLogServiceMockRecorder) List(ctx, filter any) *MockAuditLogServiceListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuditLogService)(nil).List), ctx, filter)
+	return &MockAuditLogServiceListCall{Call: call}
+}
+
+// MockAuditLogServiceListCall wrap *gomock.Call
+type MockAuditLogServiceListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAuditLogServiceListCall) Return(arg0 []AuditLog, arg1 error) *MockAuditLogServiceListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAuditLogServiceListCall) Do(f func(context.Context, AuditLogFilter) ([]AuditLog, error)) *MockAuditLogServiceListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAuditLogServiceListCall) DoAndReturn(f func(context.Context, AuditLogFilter) ([]AuditLog, error)) *MockAuditLogServiceListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
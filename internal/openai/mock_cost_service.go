@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cost_service.go
+//
+// Generated by this command:
+//
+//	mockgen -package openai -destination mock_cost_service.go -source cost_service.go -typed
+//
+
+// Package openai is a generated GoMock package.
+package openai
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCostService is a mock of CostService interface.
+type MockCostService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCostServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCostServiceMockRecorder is the mock recorder for MockCostService.
+type MockCostServiceMockRecorder struct {
+	mock *MockCostService
+}
+
+// NewMockCostService creates a new mock instance.
+func NewMockCostService(ctrl *gomock.Controller) *MockCostService {
+	mock := &MockCostService{ctrl: ctrl}
+	mock.recorder = &MockCostServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCostService) EXPECT() *MockCostServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockCostService) List(ctx context.Context, params CostParams) ([]CostBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, params)
+	ret0, _ := ret[0].([]CostBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCostServiceMockRecorder) List(ctx, params any) *MockCostServiceListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCostService)(nil).List), ctx, params)
+	return &MockCostServiceListCall{Call: call}
+}
+
+// MockCostServiceListCall wrap *gomock.Call
+type MockCostServiceListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCostServiceListCall) Return(arg0 []CostBucket, arg1 error) *MockCostServiceListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCostServiceListCall) Do(f func(context.Context, CostParams) ([]CostBucket, error)) *MockCostServiceListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCostServiceListCall) DoAndReturn(f func(context.Context, CostParams) ([]CostBucket, error)) *MockCostServiceListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -41,18 +41,23 @@ func (m *MockInviteService) EXPECT() *MockInviteServiceMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockInviteService) Create(ctx context.Context, email string, role InviteRole) (*Invite, error) {
+func (m *MockInviteService) Create(ctx context.Context, email string, role InviteRole, projects ...InviteProject) (*Invite, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, email, role)
+	varargs := []any{ctx, email, role}
+	for _, a := range projects {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Create", varargs...)
 	ret0, _ := ret[0].(*Invite)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockInviteServiceMockRecorder) Create(ctx, email, role any) *MockInviteServiceCreateCall {
+func (mr *MockInviteServiceMockRecorder) Create(ctx, email, role any, projects ...any) *MockInviteServiceCreateCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInviteService)(nil).Create), ctx, email, role)
+	varargs := append([]any{ctx, email, role}, projects...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInviteService)(nil).Create), varargs...)
 	return &MockInviteServiceCreateCall{Call: call}
 }
 
@@ -68,13 +73,13 @@ func (c *MockInviteServiceCreateCall) Return(arg0 *Invite, arg1 error) *MockInvi
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInviteServiceCreateCall) Do(f func(context.Context, string, InviteRole) (*Invite, error)) *MockInviteServiceCreateCall {
+func (c *MockInviteServiceCreateCall) Do(f func(context.Context, string, InviteRole, ...InviteProject) (*Invite, error)) *MockInviteServiceCreateCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInviteServiceCreateCall) DoAndReturn(f func(context.Context, string, InviteRole) (*Invite, error)) *MockInviteServiceCreateCall {
+func (c *MockInviteServiceCreateCall) DoAndReturn(f func(context.Context, string, InviteRole, ...InviteProject) (*Invite, error)) *MockInviteServiceCreateCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: project_rate_limit_service.go
+//
+// Generated by this command:
+//
+//	mockgen -package openai -destination mock_project_rate_limit_service.go -source project_rate_limit_service.go -typed
+//
+
+// Package openai is a generated GoMock package.
+package openai
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProjectRateLimitService is a mock of ProjectRateLimitService interface.
+type MockProjectRateLimitService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectRateLimitServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectRateLimitServiceMockRecorder is the mock recorder for MockProjectRateLimitService.
+type MockProjectRateLimitServiceMockRecorder struct {
+	mock *MockProjectRateLimitService
+}
+
+// NewMockProjectRateLimitService creates a new mock instance.
+func NewMockProjectRateLimitService(ctrl *gomock.Controller) *MockProjectRateLimitService {
+	mock := &MockProjectRateLimitService{ctrl: ctrl}
+	mock.recorder = &MockProjectRateLimitServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectRateLimitService) EXPECT() *MockProjectRateLimitServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockProjectRateLimitService) List(ctx context.Context, projectID string) ([]ProjectRateLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, projectID)
+	ret0, _ := ret[0].([]ProjectRateLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockProjectRateLimitServiceMockRecorder) List(ctx, projectID any) *MockProjectRateLimitServiceListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectRateLimitService)(nil).List), ctx, projectID)
+	return &MockProjectRateLimitServiceListCall{Call: call}
+}
+
+// MockProjectRateLimitServiceListCall wrap *gomock.Call
+type MockProjectRateLimitServiceListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectRateLimitServiceListCall) Return(arg0 []ProjectRateLimit, arg1 error) *MockProjectRateLimitServiceListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectRateLimitServiceListCall) Do(f func(context.Context, string) ([]ProjectRateLimit, error)) *MockProjectRateLimitServiceListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectRateLimitServiceListCall) DoAndReturn(f func(context.Context, string) ([]ProjectRateLimit, error)) *MockProjectRateLimitServiceListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Modify mocks base method.
+func (m *MockProjectRateLimitService) Modify(ctx context.Context, projectID, rateLimitID string, body ProjectRateLimitModifyBody) (*ProjectRateLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Modify", ctx, projectID, rateLimitID, body)
+	ret0, _ := ret[0].(*ProjectRateLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Modify indicates an expected call of Modify.
+func (mr *MockProjectRateLimitServiceMockRecorder) Modify(ctx, projectID, rateLimitID, body any) *MockProjectRateLimitServiceModifyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Modify", reflect.TypeOf((*MockProjectRateLimitService)(nil).Modify), ctx, projectID, rateLimitID, body)
+	return &MockProjectRateLimitServiceModifyCall{Call: call}
+}
+
+// MockProjectRateLimitServiceModifyCall wrap *gomock.Call
+type MockProjectRateLimitServiceModifyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectRateLimitServiceModifyCall) Return(arg0 *ProjectRateLimit, arg1 error) *MockProjectRateLimitServiceModifyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectRateLimitServiceModifyCall) Do(f func(context.Context, string, string, ProjectRateLimitModifyBody) (*ProjectRateLimit, error)) *MockProjectRateLimitServiceModifyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectRateLimitServiceModifyCall) DoAndReturn(f func(context.Context, string, string, ProjectRateLimitModifyBody) (*ProjectRateLimit, error)) *MockProjectRateLimitServiceModifyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -156,6 +156,45 @@ func (c *MockProjectServiceListCall) DoAndReturn(f func(context.Context) ([]Proj
 	return c
 }
 
+// ListWithArchived mocks base method.
+func (m *MockProjectService) ListWithArchived(ctx context.Context, includeArchived bool) ([]Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithArchived", ctx, includeArchived)
+	ret0, _ := ret[0].([]Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithArchived indicates an expected call of ListWithArchived.
+func (mr *MockProjectServiceMockRecorder) ListWithArchived(ctx, includeArchived any) *MockProjectServiceListWithArchivedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithArchived", reflect.TypeOf((*MockProjectService)(nil).ListWithArchived), ctx, includeArchived)
+	return &MockProjectServiceListWithArchivedCall{Call: call}
+}
+
+// MockProjectServiceListWithArchivedCall wrap *gomock.Call
+type MockProjectServiceListWithArchivedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectServiceListWithArchivedCall) Return(arg0 []Project, arg1 error) *MockProjectServiceListWithArchivedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectServiceListWithArchivedCall) Do(f func(context.Context, bool) ([]Project, error)) *MockProjectServiceListWithArchivedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectServiceListWithArchivedCall) DoAndReturn(f func(context.Context, bool) ([]Project, error)) *MockProjectServiceListWithArchivedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Modify mocks base method.
 func (m *MockProjectService) Modify(ctx context.Context, projectID, name string) (*Project, error) {
 	m.ctrl.T.Helper()
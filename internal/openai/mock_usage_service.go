@@ -0,0 +1,353 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usage_service.go
+//
+// Generated by this command:
+//
+//	mockgen -package openai -destination mock_usage_service.go -source usage_service.go -typed
+//
+
+// Package openai is a generated GoMock package.
+package openai
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsageService is a mock of UsageService interface.
+type MockUsageService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsageServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockUsageServiceMockRecorder is the mock recorder for MockUsageService.
+type MockUsageServiceMockRecorder struct {
+	mock *MockUsageService
+}
+
+// NewMockUsageService creates a new mock instance.
+func NewMockUsageService(ctrl *gomock.Controller) *MockUsageService {
+	mock := &MockUsageService{ctrl: ctrl}
+	mock.recorder = &MockUsageServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsageService) EXPECT() *MockUsageServiceMockRecorder {
+	return m.recorder
+}
+
+// AudioSpeeches mocks base method.
+func (m *MockUsageService) AudioSpeeches(ctx context.Context, params UsageParams) ([]UsageBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AudioSpeeches", ctx, params)
+	ret0, _ := ret[0].([]UsageBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AudioSpeeches indicates an expected call of AudioSpeeches.
+func (mr *MockUsageServiceMockRecorder) AudioSpeeches(ctx, params any) *MockUsageServiceAudioSpeechesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AudioSpeeches", reflect.TypeOf((*MockUsageService)(nil).AudioSpeeches), ctx, params)
+	return &MockUsageServiceAudioSpeechesCall{Call: call}
+}
+
+// MockUsageServiceAudioSpeechesCall wrap *gomock.Call
+type MockUsageServiceAudioSpeechesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUsageServiceAudioSpeechesCall) Return(arg0 []UsageBucket, arg1 error) *MockUsageServiceAudioSpeechesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUsageServiceAudioSpeechesCall) Do(f func(context.Context, UsageParams) ([]UsageBucket, error)) *MockUsageServiceAudioSpeechesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUsageServiceAudioSpeechesCall) DoAndReturn(f func(context.Context, UsageParams) ([]UsageBucket, error)) *MockUsageServiceAudioSpeechesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AudioTranscriptions mocks base method.
+func (m *MockUsageService) AudioTranscriptions(ctx context.Context, params UsageParams) ([]UsageBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AudioTranscriptions", ctx, params)
+	ret0, _ := ret[0].([]UsageBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AudioTranscriptions indicates an expected call of AudioTranscriptions.
+func (mr *MockUsageServiceMockRecorder) AudioTranscriptions(ctx, params any) *MockUsageServiceAudioTranscriptionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AudioTranscriptions", reflect.TypeOf((*MockUsageService)(nil).AudioTranscriptions), ctx, params)
+	return &MockUsageServiceAudioTranscriptionsCall{Call: call}
+}
+
+// MockUsageServiceAudioTranscriptionsCall wrap *gomock.Call
+type MockUsageServiceAudioTranscriptionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUsageServiceAudioTranscriptionsCall) Return(arg0 []UsageBucket, arg1 error) *MockUsageServiceAudioTranscriptionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUsageServiceAudioTranscriptionsCall) Do(f func(context.Context, UsageParams) ([]UsageBucket, error)) *MockUsageServiceAudioTranscriptionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUsageServiceAudioTranscriptionsCall) DoAndReturn(f func(context.Context, UsageParams) ([]UsageBucket, error)) *MockUsageServiceAudioTranscriptionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CodeInterpreterSessions mocks base method.
+func (m *MockUsageService) CodeInterpreterSessions(ctx context.Context, params UsageParams) ([]UsageBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CodeInterpreterSessions", ctx, params)
+	ret0, _ := ret[0].([]UsageBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CodeInterpreterSessions indicates an expected call of CodeInterpreterSessions.
+func (mr *MockUsageServiceMockRecorder) CodeInterpreterSessions(ctx, params any) *MockUsageServiceCodeInterpreterSessionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CodeInterpreterSessions", reflect.TypeOf((*MockUsageService)(nil).CodeInterpreterSessions), ctx, params)
+	return &MockUsageServiceCodeInterpreterSessionsCall{Call: call}
+}
+
+// MockUsageServiceCodeInterpreterSessionsCall wrap *gomock.Call
+type MockUsageServiceCodeInterpreterSessionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUsageServiceCodeInterpreterSessionsCall) Return(arg0 []UsageBucket, arg1 error) *MockUsageServiceCodeInterpreterSessionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUsageServiceCodeInterpreterSessionsCall) Do(f func(context.Context, UsageParams) ([]UsageBucket, error)) *MockUsageServiceCodeInterpreterSessionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUsageServiceCodeInterpreterSessionsCall) DoAndReturn(f func(context.Context, UsageParams) ([]UsageBucket, error)) *MockUsageServiceCodeInterpreterSessionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Completions mocks base method.
+func (m *MockUsageService) Completions(ctx context.Context, params UsageParams) ([]UsageBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Completions", ctx, params)
+	ret0, _ := ret[0].([]UsageBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Completions indicates an expected call of Completions.
+func (mr *MockUsageServiceMockRecorder) Completions(ctx, params any) *MockUsageServiceCompletionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Completions", reflect.TypeOf((*MockUsageService)(nil).Completions), ctx, params)
+	return &MockUsageServiceCompletionsCall{Call: call}
+}
+
+// MockUsageServiceCompletionsCall wrap *gomock.Call
+type MockUsageServiceCompletionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUsageServiceCompletionsCall) Return(arg0 []UsageBucket, arg1 error) *MockUsageServiceCompletionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUsageServiceCompletionsCall) Do(f func(context.Context, UsageParams) ([]UsageBucket, error)) *MockUsageServiceCompletionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUsageServiceCompletionsCall) DoAndReturn(f func(context.Context, UsageParams) ([]UsageBucket, error)) *MockUsageServiceCompletionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Embeddings mocks base method.
+func (m *MockUsageService) Embeddings(ctx context.Context, params UsageParams) ([]UsageBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Embeddings", ctx, params)
+	ret0, _ := ret[0].([]UsageBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Embeddings indicates an expected call of Embeddings.
+func (mr *MockUsageServiceMockRecorder) Embeddings(ctx, params any) *MockUsageServiceEmbeddingsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Embeddings", reflect.TypeOf((*MockUsageService)(nil).Embeddings), ctx, params)
+	return &MockUsageServiceEmbeddingsCall{Call: call}
+}
+
+// MockUsageServiceEmbeddingsCall wrap *gomock.Call
+type MockUsageServiceEmbeddingsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUsageServiceEmbeddingsCall) Return(arg0 []UsageBucket, arg1 error) *MockUsageServiceEmbeddingsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUsageServiceEmbeddingsCall) Do(f func(context.Context, UsageParams) ([]UsageBucket, error)) *MockUsageServiceEmbeddingsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUsageServiceEmbeddingsCall) DoAndReturn(f func(context.Context, UsageParams) ([]UsageBucket, error)) *MockUsageServiceEmbeddingsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Images mocks base method.
+func (m *MockUsageService) Images(ctx context.Context, params UsageParams) ([]UsageBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Images", ctx, params)
+	ret0, _ := ret[0].([]UsageBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Images indicates an expected call of Images.
+func (mr *MockUsageServiceMockRecorder) Images(ctx, params any) *MockUsageServiceImagesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Images", reflect.TypeOf((*MockUsageService)(nil).Images), ctx, params)
+	return &MockUsageServiceImagesCall{Call: call}
+}
+
+// MockUsageServiceImagesCall wrap *gomock.Call
+type MockUsageServiceImagesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUsageServiceImagesCall) Return(arg0 []UsageBucket, arg1 error) *MockUsageServiceImagesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUsageServiceImagesCall) Do(f func(context.Context, UsageParams) ([]UsageBucket, error)) *MockUsageServiceImagesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUsageServiceImagesCall) DoAndReturn(f func(context.Context, UsageParams) ([]UsageBucket, error)) *MockUsageServiceImagesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Moderations mocks base method.
+func (m *MockUsageService) Moderations(ctx context.Context, params UsageParams) ([]UsageBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Moderations", ctx, params)
+	ret0, _ := ret[0].([]UsageBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Moderations indicates an expected call of Moderations.
+func (mr *MockUsageServiceMockRecorder) Moderations(ctx, params any) *MockUsageServiceModerationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Moderations", reflect.TypeOf((*MockUsageService)(nil).Moderations), ctx, params)
+	return &MockUsageServiceModerationsCall{Call: call}
+}
+
+// MockUsageServiceModerationsCall wrap *gomock.Call
+type MockUsageServiceModerationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUsageServiceModerationsCall) Return(arg0 []UsageBucket, arg1 error) *MockUsageServiceModerationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUsageServiceModerationsCall) Do(f func(context.Context, UsageParams) ([]UsageBucket, error)) *MockUsageServiceModerationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUsageServiceModerationsCall) DoAndReturn(f func(context.Context, UsageParams) ([]UsageBucket, error)) *MockUsageServiceModerationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// VectorStores mocks base method.
+func (m *MockUsageService) VectorStores(ctx context.Context, params UsageParams) ([]UsageBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VectorStores", ctx, params)
+	ret0, _ := ret[0].([]UsageBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VectorStores indicates an expected call of VectorStores.
+func (mr *MockUsageServiceMockRecorder) VectorStores(ctx, params any) *MockUsageServiceVectorStoresCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VectorStores", reflect.TypeOf((*MockUsageService)(nil).VectorStores), ctx, params)
+	return &MockUsageServiceVectorStoresCall{Call: call}
+}
+
+// MockUsageServiceVectorStoresCall wrap *gomock.Call
+type MockUsageServiceVectorStoresCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUsageServiceVectorStoresCall) Return(arg0 []UsageBucket, arg1 error) *MockUsageServiceVectorStoresCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUsageServiceVectorStoresCall) Do(f func(context.Context, UsageParams) ([]UsageBucket, error)) *MockUsageServiceVectorStoresCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUsageServiceVectorStoresCall) DoAndReturn(f func(context.Context, UsageParams) ([]UsageBucket, error)) *MockUsageServiceVectorStoresCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -88,7 +88,7 @@ func (s sdkProjectAPIKeyService) List(ctx context.Context, projectID string) ([]
 
 	for {
 		var result ProjectAPIKeyListResponse
-		err := s.client.Get(ctx, "/organization/projects/"+projectID+"/api_keys", params.URLQuery(), &result)
+		err := s.client.Get(ctx, "/organization/projects/"+projectID+"/api_keys", params, &result)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
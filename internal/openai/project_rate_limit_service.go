@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:generate mockgen -package "$GOPACKAGE" -destination "mock_$GOFILE" -source "$GOFILE" -typed
+
+package openai
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/openai/openai-go"
+	"github.com/pkg/errors"
+)
+
+type ProjectRateLimitService interface {
+	List(ctx context.Context, projectID string) ([]ProjectRateLimit, error)
+	Modify(
+		ctx context.Context,
+		projectID, rateLimitID string,
+		body ProjectRateLimitModifyBody,
+	) (*ProjectRateLimit, error)
+}
+
+// sdkProjectRateLimitService handles operations related to project rate limits in the OpenAI admin API.
+type sdkProjectRateLimitService struct {
+	client *openai.Client
+}
+
+func NewSDKProjectRateLimitService(client *openai.Client) ProjectRateLimitService {
+	return sdkProjectRateLimitService{client: client}
+}
+
+// ProjectRateLimit represents the per-model rate limits of a project.
+type ProjectRateLimit struct {
+	ID                          string `json:"id"`
+	Model                       string `json:"model"`
+	MaxRequestsPer1Minute       int64  `json:"max_requests_per_1_minute"`
+	MaxTokensPer1Minute         int64  `json:"max_tokens_per_1_minute"`
+	MaxImagesPer1Minute         *int64 `json:"max_images_per_1_minute,omitempty"`
+	MaxAudioMegabytesPer1Minute *int64 `json:"max_audio_megabytes_per_1_minute,omitempty"`
+	MaxRequestsPer1Day          *int64 `json:"max_requests_per_1_day,omitempty"`
+	Batch1DayMaxInputTokens     *int64 `json:"batch_1_day_max_input_tokens,omitempty"`
+}
+
+type ProjectRateLimitListParams struct {
+	After *string
+	Limit *int
+}
+
+func (p ProjectRateLimitListParams) URLQuery() url.Values {
+	v := url.Values{}
+	if p.After != nil {
+		v.Set("after", *p.After)
+	}
+	if p.Limit != nil {
+		v.Set("limit", strconv.Itoa(*p.Limit))
+	}
+	return v
+}
+
+type ProjectRateLimitListResponse struct {
+	Data    []ProjectRateLimit `json:"data"`
+	FirstID string             `json:"first_id"`
+	LastID  string             `json:"last_id"`
+	HasMore bool               `json:"has_more"`
+}
+
+// List retrieves all rate limits for a project, with optional pagination parameters.
+func (s sdkProjectRateLimitService) List(ctx context.Context, projectID string) ([]ProjectRateLimit, error) {
+	var rateLimits []ProjectRateLimit
+
+	limit := 100
+	params := ProjectRateLimitListParams{
+		Limit: &limit,
+	}
+
+	for {
+		var result ProjectRateLimitListResponse
+		err := s.client.Get(ctx, "/organization/projects/"+projectID+"/rate_limits", params, &result)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		rateLimits = append(rateLimits, result.Data...)
+		if !result.HasMore {
+			break
+		}
+		params.After = &result.LastID
+	}
+
+	return rateLimits, nil
+}
+
+// ProjectRateLimitModifyBody represents the parameters for modifying a project rate limit.
+// Fields left nil are not changed.
+type ProjectRateLimitModifyBody struct {
+	MaxRequestsPer1Minute       *int64 `json:"max_requests_per_1_minute,omitempty"`
+	MaxTokensPer1Minute         *int64 `json:"max_tokens_per_1_minute,omitempty"`
+	MaxImagesPer1Minute         *int64 `json:"max_images_per_1_minute,omitempty"`
+	MaxAudioMegabytesPer1Minute *int64 `json:"max_audio_megabytes_per_1_minute,omitempty"`
+	MaxRequestsPer1Day          *int64 `json:"max_requests_per_1_day,omitempty"`
+	Batch1DayMaxInputTokens     *int64 `json:"batch_1_day_max_input_tokens,omitempty"`
+}
+
+// Modify updates a project rate limit by its ID.
+func (s sdkProjectRateLimitService) Modify(
+	ctx context.Context,
+	projectID, rateLimitID string,
+	body ProjectRateLimitModifyBody,
+) (*ProjectRateLimit, error) {
+	var result ProjectRateLimit
+	err := s.client.Post(
+		ctx,
+		"/organization/projects/"+projectID+"/rate_limits/"+rateLimitID,
+		body,
+		&result,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &result, nil
+}
@@ -17,6 +17,7 @@ import (
 
 type ProjectService interface {
 	List(ctx context.Context) ([]Project, error)
+	ListWithArchived(ctx context.Context, includeArchived bool) ([]Project, error)
 	Create(ctx context.Context, name string) (*Project, error)
 	Retrieve(ctx context.Context, projectID string) (*Project, error)
 	Modify(ctx context.Context, projectID, name string) (*Project, error)
@@ -50,8 +51,9 @@ type Project struct {
 
 // ProjectListParams represents the query parameters for listing projects.
 type ProjectListParams struct {
-	Limit *int
-	After *string
+	Limit           *int
+	After           *string
+	IncludeArchived *bool
 }
 
 func (p ProjectListParams) URLQuery() url.Values {
@@ -62,16 +64,25 @@ func (p ProjectListParams) URLQuery() url.Values {
 	if p.After != nil {
 		v.Set("after", *p.After)
 	}
+	if p.IncludeArchived != nil {
+		v.Set("include_archived", strconv.FormatBool(*p.IncludeArchived))
+	}
 	return v
 }
 
-// List retrieves a list of projects.
+// List retrieves a list of active projects.
 func (s SDKProjectService) List(ctx context.Context) ([]Project, error) {
+	return s.ListWithArchived(ctx, false)
+}
+
+// ListWithArchived retrieves a list of projects, including the archived ones when includeArchived is true.
+func (s SDKProjectService) ListWithArchived(ctx context.Context, includeArchived bool) ([]Project, error) {
 	var projects []Project
 
 	limit := 100
 	params := ProjectListParams{
-		Limit: &limit,
+		Limit:           &limit,
+		IncludeArchived: &includeArchived,
 	}
 
 	for {
@@ -81,7 +92,7 @@ func (s SDKProjectService) List(ctx context.Context) ([]Project, error) {
 			LastID  string    `json:"last_id"`
 		}
 
-		err := s.client.Get(ctx, "/organization/projects", params.URLQuery(), &result)
+		err := s.client.Get(ctx, "/organization/projects", params, &result)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:generate mockgen -package "$GOPACKAGE" -destination "mock_$GOFILE" -source "$GOFILE" -typed
+
+package openai
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/isac322/terraform-provider-openaiadmin/internal/utils"
+	"github.com/openai/openai-go"
+	"github.com/pkg/errors"
+)
+
+type UsageService interface {
+	Completions(ctx context.Context, params UsageParams) ([]UsageBucket, error)
+	Embeddings(ctx context.Context, params UsageParams) ([]UsageBucket, error)
+	Moderations(ctx context.Context, params UsageParams) ([]UsageBucket, error)
+	Images(ctx context.Context, params UsageParams) ([]UsageBucket, error)
+	AudioSpeeches(ctx context.Context, params UsageParams) ([]UsageBucket, error)
+	AudioTranscriptions(ctx context.Context, params UsageParams) ([]UsageBucket, error)
+	VectorStores(ctx context.Context, params UsageParams) ([]UsageBucket, error)
+	CodeInterpreterSessions(ctx context.Context, params UsageParams) ([]UsageBucket, error)
+}
+
+// sdkUsageService handles operations related to the organization usage API.
+type sdkUsageService struct {
+	client *openai.Client
+}
+
+func NewSDKUsageService(client *openai.Client) UsageService {
+	return sdkUsageService{client: client}
+}
+
+// UsageBucketWidth represents the possible widths of a usage bucket.
+type UsageBucketWidth string
+
+const (
+	UsageBucketWidthMinute UsageBucketWidth = "1m"
+	UsageBucketWidthHour   UsageBucketWidth = "1h"
+	UsageBucketWidthDay    UsageBucketWidth = "1d"
+)
+
+// UsageResult is a single aggregated usage record within a bucket.
+// Dimension fields are only set when the results are grouped by them,
+// and only the metrics of the requested usage type are populated.
+type UsageResult struct {
+	ProjectID *string `json:"project_id,omitempty"`
+	UserID    *string `json:"user_id,omitempty"`
+	APIKeyID  *string `json:"api_key_id,omitempty"`
+	Model     *string `json:"model,omitempty"`
+	Batch     *bool   `json:"batch,omitempty"`
+	Source    *string `json:"source,omitempty"`
+	Size      *string `json:"size,omitempty"`
+
+	InputTokens       int64 `json:"input_tokens"`
+	OutputTokens      int64 `json:"output_tokens"`
+	InputCachedTokens int64 `json:"input_cached_tokens"`
+	InputAudioTokens  int64 `json:"input_audio_tokens"`
+	OutputAudioTokens int64 `json:"output_audio_tokens"`
+	NumModelRequests  int64 `json:"num_model_requests"`
+	Images            int64 `json:"images"`
+	Characters        int64 `json:"characters"`
+	Seconds           int64 `json:"seconds"`
+	UsageBytes        int64 `json:"usage_bytes"`
+	NumSessions       int64 `json:"num_sessions"`
+}
+
+type UsageBucket struct {
+	StartTime utils.UnixTimestamp `json:"start_time"`
+	EndTime   utils.UnixTimestamp `json:"end_time"`
+	Results   []UsageResult       `json:"results"`
+}
+
+// UsageParams represents the query parameters shared by all usage endpoints.
+// Empty fields are not sent to the API; not every endpoint supports every filter.
+type UsageParams struct {
+	StartTime   time.Time
+	EndTime     *time.Time
+	BucketWidth *UsageBucketWidth
+	ProjectIDs  []string
+	UserIDs     []string
+	APIKeyIDs   []string
+	Models      []string
+	Batch       *bool
+	Sources     []string
+	Sizes       []string
+	GroupBy     []string
+}
+
+type UsageListParams struct {
+	UsageParams
+	Page *string
+}
+
+func (p UsageListParams) URLQuery() url.Values {
+	v := url.Values{}
+	v.Set("start_time", strconv.FormatInt(p.StartTime.Unix(), 10))
+	if p.EndTime != nil {
+		v.Set("end_time", strconv.FormatInt(p.EndTime.Unix(), 10))
+	}
+	if p.BucketWidth != nil {
+		v.Set("bucket_width", string(*p.BucketWidth))
+	}
+	if p.Batch != nil {
+		v.Set("batch", strconv.FormatBool(*p.Batch))
+	}
+	if p.Page != nil {
+		v.Set("page", *p.Page)
+	}
+
+	addAll := func(key string, values []string) {
+		for _, value := range values {
+			v.Add(key, value)
+		}
+	}
+	addAll("project_ids[]", p.ProjectIDs)
+	addAll("user_ids[]", p.UserIDs)
+	addAll("api_key_ids[]", p.APIKeyIDs)
+	addAll("models[]", p.Models)
+	addAll("sources[]", p.Sources)
+	addAll("sizes[]", p.Sizes)
+	addAll("group_by[]", p.GroupBy)
+
+	return v
+}
+
+type UsageListResponse struct {
+	Data     []UsageBucket `json:"data"`
+	HasMore  bool          `json:"has_more"`
+	NextPage *string       `json:"next_page"`
+}
+
+// list retrieves all usage buckets of an endpoint, following the next_page cursor.
+func (s sdkUsageService) list(ctx context.Context, path string, usageParams UsageParams) ([]UsageBucket, error) {
+	var buckets []UsageBucket
+
+	params := UsageListParams{
+		UsageParams: usageParams,
+	}
+
+	for {
+		var result UsageListResponse
+		err := s.client.Get(ctx, "/organization/usage/"+path, params, &result)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		buckets = append(buckets, result.Data...)
+		if !result.HasMore || result.NextPage == nil {
+			break
+		}
+		params.Page = result.NextPage
+	}
+
+	return buckets, nil
+}
+
+// Completions retrieves the completions usage of the organization.
+func (s sdkUsageService) Completions(ctx context.Context, params UsageParams) ([]UsageBucket, error) {
+	return s.list(ctx, "completions", params)
+}
+
+// Embeddings retrieves the embeddings usage of the organization.
+func (s sdkUsageService) Embeddings(ctx context.Context, params UsageParams) ([]UsageBucket, error) {
+	return s.list(ctx, "embeddings", params)
+}
+
+// Moderations retrieves the moderations usage of the organization.
+func (s sdkUsageService) Moderations(ctx context.Context, params UsageParams) ([]UsageBucket, error) {
+	return s.list(ctx, "moderations", params)
+}
+
+// Images retrieves the images usage of the organization.
+func (s sdkUsageService) Images(ctx context.Context, params UsageParams) ([]UsageBucket, error) {
+	return s.list(ctx, "images", params)
+}
+
+// AudioSpeeches retrieves the audio speeches usage of the organization.
+func (s sdkUsageService) AudioSpeeches(ctx context.Context, params UsageParams) ([]UsageBucket, error) {
+	return s.list(ctx, "audio_speeches", params)
+}
+
+// AudioTranscriptions retrieves the audio transcriptions usage of the organization.
+func (s sdkUsageService) AudioTranscriptions(ctx context.Context, params UsageParams) ([]UsageBucket, error) {
+	return s.list(ctx, "audio_transcriptions", params)
+}
+
+// VectorStores retrieves the vector stores usage of the organization.
+func (s sdkUsageService) VectorStores(ctx context.Context, params UsageParams) ([]UsageBucket, error) {
+	return s.list(ctx, "vector_stores", params)
+}
+
+// CodeInterpreterSessions retrieves the code interpreter sessions usage of the organization.
+func (s sdkUsageService) CodeInterpreterSessions(ctx context.Context, params UsageParams) ([]UsageBucket, error) {
+	return s.list(ctx, "code_interpreter_sessions", params)
+}
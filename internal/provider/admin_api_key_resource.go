@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AdminAPIKeyResource{}
+var _ resource.ResourceWithImportState = &AdminAPIKeyResource{}
+
+type AdminAPIKeyResource struct {
+	client openai.Client
+}
+
+type AdminAPIKeyModel struct {
+	ID            types.String      `tfsdk:"id"`
+	Name          types.String      `tfsdk:"name"`
+	Value         types.String      `tfsdk:"value"`
+	RedactedValue types.String      `tfsdk:"redacted_value"`
+	CreatedAt     timetypes.RFC3339 `tfsdk:"created_at"`
+}
+
+func NewAdminAPIKeyResource() resource.Resource {
+	return &AdminAPIKeyResource{}
+}
+
+func (r *AdminAPIKeyResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_admin_api_key"
+}
+
+func (r *AdminAPIKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Organization Admin API Key resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the admin API key.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the admin API key.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The actual API key value, available only during creation.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"redacted_value": schema.StringAttribute{
+				MarkdownDescription: "The redacted value of the admin API key.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "The timestamp when the admin API key was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AdminAPIKeyResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AdminAPIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AdminAPIKeyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKey, err := r.client.AdminAPIKeys.Create(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating admin API key", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	data.ID = types.StringValue(apiKey.ID)
+	data.Name = types.StringValue(apiKey.Name)
+	data.Value = types.StringValue(apiKey.Value)
+	data.RedactedValue = types.StringValue(apiKey.RedactedValue)
+	data.CreatedAt = timetypes.NewRFC3339TimeValue(apiKey.CreatedAt.Time)
+
+	tflog.Trace(ctx, "Created an Admin API Key resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdminAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AdminAPIKeyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKey, err := r.client.AdminAPIKeys.Retrieve(ctx, data.ID.ValueString())
+	if err != nil {
+		if openai.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading admin API key", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	data.Name = types.StringValue(apiKey.Name)
+	data.RedactedValue = types.StringValue(apiKey.RedactedValue)
+	data.CreatedAt = timetypes.NewRFC3339TimeValue(apiKey.CreatedAt.Time)
+	// The key value is only provided on creation, so do not change it.
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdminAPIKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AdminAPIKeyModel
+
+	// Every configurable attribute requires replacement, so there is nothing to send to the API.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdminAPIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AdminAPIKeyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.AdminAPIKeys.Delete(ctx, data.ID.ValueString())
+	if err != nil && !openai.IsNotFoundError(err) {
+		resp.Diagnostics.AddError("Error deleting admin API key", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *AdminAPIKeyResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+	"github.com/pkg/errors"
+)
+
+func generateTestAdminAPIKey() string {
+	return fmt.Sprintf("test_admin_key_%d", time.Now().UnixNano())
+}
+
+func TestAccAdminAPIKeyResource(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	name := generateTestAdminAPIKey()
+	updatedName := generateTestAdminAPIKey()
+	resourceName := "openaiadmin_admin_api_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdminAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAdminAPIKeyResourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "value"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestMatchResourceAttr(resourceName, "redacted_value", regexp.MustCompile(`^sk-admin`)),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value"},
+			},
+			// Update testing (requires replace)
+			{
+				Config: testAccAdminAPIKeyResourceConfig(updatedName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+					resource.TestCheckResourceAttrSet(resourceName, "value"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAdminAPIKeyResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_admin_api_key" "test" {
+  name = %[1]q
+}
+`, name)
+}
+
+func testAccCheckAdminAPIKeyDestroy(s *terraform.State) error {
+	client := openai.NewSDKClient(os.Getenv("OPENAI_ADMIN_TOKEN"), nil)
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openaiadmin_admin_api_key" {
+			continue
+		}
+
+		_, err := client.AdminAPIKeys.Retrieve(ctx, rs.Primary.ID)
+		if err == nil {
+			return errors.Errorf("Admin API Key still exists: %s", rs.Primary.ID)
+		}
+		if !openai.IsNotFoundError(err) {
+			return errors.Wrapf(err, "error retrieving Admin API Key (%s)", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
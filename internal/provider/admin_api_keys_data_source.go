@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+type AdminAPIKeysDataSource struct {
+	client openai.Client
+}
+
+type AdminAPIKeyOwnerData struct {
+	Type      types.String      `tfsdk:"type"`
+	ID        types.String      `tfsdk:"id"`
+	Name      types.String      `tfsdk:"name"`
+	CreatedAt timetypes.RFC3339 `tfsdk:"created_at"`
+	Role      types.String      `tfsdk:"role"`
+}
+
+type AdminAPIKeyData struct {
+	ID            types.String         `tfsdk:"id"`
+	Name          types.String         `tfsdk:"name"`
+	RedactedValue types.String         `tfsdk:"redacted_value"`
+	CreatedAt     timetypes.RFC3339    `tfsdk:"created_at"`
+	LastUsedAt    timetypes.RFC3339    `tfsdk:"last_used_at"`
+	Owner         AdminAPIKeyOwnerData `tfsdk:"owner"`
+}
+
+type AdminAPIKeysDataSourceModel struct {
+	AdminAPIKeys []AdminAPIKeyData `tfsdk:"admin_api_keys"`
+}
+
+func NewAdminAPIKeysDataSource() datasource.DataSource {
+	return &AdminAPIKeysDataSource{}
+}
+
+func (d *AdminAPIKeysDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_admin_api_keys"
+}
+
+func (d *AdminAPIKeysDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve a list of all admin API keys of the organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"admin_api_keys": schema.ListNestedAttribute{
+				MarkdownDescription: "List of all admin API keys.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"redacted_value": schema.StringAttribute{
+							Computed: true,
+						},
+						"created_at": schema.StringAttribute{
+							CustomType: timetypes.RFC3339Type{},
+							Computed:   true,
+						},
+						"last_used_at": schema.StringAttribute{
+							CustomType: timetypes.RFC3339Type{},
+							Computed:   true,
+						},
+						"owner": schema.SingleNestedAttribute{
+							MarkdownDescription: "The owner of the admin API key.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									MarkdownDescription: "The type of the owner, either 'user' or 'service_account'.",
+									Computed:            true,
+								},
+								"id": schema.StringAttribute{
+									MarkdownDescription: "The ID of the owner.",
+									Computed:            true,
+								},
+								"name": schema.StringAttribute{
+									MarkdownDescription: "The name of the owner.",
+									Computed:            true,
+								},
+								"created_at": schema.StringAttribute{
+									CustomType:          timetypes.RFC3339Type{},
+									MarkdownDescription: "The timestamp when the owner was created.",
+									Computed:            true,
+								},
+								"role": schema.StringAttribute{
+									MarkdownDescription: "The role of the owner.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AdminAPIKeysDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			))
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AdminAPIKeysDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AdminAPIKeysDataSourceModel
+
+	apiKeys, err := d.client.AdminAPIKeys.List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading admin API keys list", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	for _, apiKey := range apiKeys {
+		data.AdminAPIKeys = append(data.AdminAPIKeys, newAdminAPIKeyData(apiKey))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func newAdminAPIKeyData(apiKey openai.AdminAPIKey) AdminAPIKeyData {
+	lastUsedAt := timetypes.NewRFC3339Null()
+	if apiKey.LastUsedAt != nil {
+		lastUsedAt = timetypes.NewRFC3339TimeValue(apiKey.LastUsedAt.Time)
+	}
+
+	return AdminAPIKeyData{
+		ID:            types.StringValue(apiKey.ID),
+		Name:          types.StringValue(apiKey.Name),
+		RedactedValue: types.StringValue(apiKey.RedactedValue),
+		CreatedAt:     timetypes.NewRFC3339TimeValue(apiKey.CreatedAt.Time),
+		LastUsedAt:    lastUsedAt,
+		Owner: AdminAPIKeyOwnerData{
+			Type:      types.StringValue(apiKey.Owner.Type),
+			ID:        types.StringValue(apiKey.Owner.ID),
+			Name:      types.StringValue(apiKey.Owner.Name),
+			CreatedAt: timetypes.NewRFC3339TimeValue(apiKey.Owner.CreatedAt.Time),
+			Role:      types.StringValue(apiKey.Owner.Role),
+		},
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAdminAPIKeysDataSource(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	name := generateTestAdminAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdminAPIKeysDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.openaiadmin_admin_api_keys.test", "admin_api_keys.0.id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openaiadmin_admin_api_keys.test",
+						"admin_api_keys.0.redacted_value",
+					),
+					resource.TestCheckResourceAttrSet("data.openaiadmin_admin_api_keys.test", "admin_api_keys.0.owner.id"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.openaiadmin_admin_api_keys.test",
+						"admin_api_keys.*",
+						map[string]string{"name": name},
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccAdminAPIKeysDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_admin_api_key" "test" {
+  name = %[1]q
+}
+
+data "openaiadmin_admin_api_keys" "test" {
+  depends_on = [openaiadmin_admin_api_key.test]
+}
+`, name)
+}
@@ -0,0 +1,333 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+)
+
+// defaultAPIKeyInventoryConcurrency is the number of projects that are inventoried at the same time by default.
+const defaultAPIKeyInventoryConcurrency = 4
+
+// The kinds of API keys in the inventory.
+const (
+	apiKeyInventoryKindProject = "project"
+	apiKeyInventoryKindAdmin   = "admin"
+)
+
+var _ datasource.DataSource = &APIKeyInventoryDataSource{}
+
+type APIKeyInventoryDataSource struct {
+	client openai.Client
+}
+
+type APIKeyInventoryDataSourceModel struct {
+	IncludeAdminKeys types.Bool                 `tfsdk:"include_admin_keys"`
+	Concurrency      types.Int64                `tfsdk:"concurrency"`
+	APIKeys          []APIKeyInventoryItemModel `tfsdk:"api_keys"`
+}
+
+type APIKeyInventoryItemModel struct {
+	Kind          types.String      `tfsdk:"kind"`
+	ID            types.String      `tfsdk:"id"`
+	Name          types.String      `tfsdk:"name"`
+	RedactedValue types.String      `tfsdk:"redacted_value"`
+	CreatedAt     timetypes.RFC3339 `tfsdk:"created_at"`
+	LastUsedAt    timetypes.RFC3339 `tfsdk:"last_used_at"`
+	AgeDays       types.Int64       `tfsdk:"age_days"`
+	ProjectID     types.String      `tfsdk:"project_id"`
+	ProjectName   types.String      `tfsdk:"project_name"`
+	OwnerType     types.String      `tfsdk:"owner_type"`
+	OwnerID       types.String      `tfsdk:"owner_id"`
+	OwnerName     types.String      `tfsdk:"owner_name"`
+	OwnerEmail    types.String      `tfsdk:"owner_email"`
+	OwnerRole     types.String      `tfsdk:"owner_role"`
+}
+
+func NewAPIKeyInventoryDataSource() datasource.DataSource {
+	return &APIKeyInventoryDataSource{}
+}
+
+func (d *APIKeyInventoryDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_api_key_inventory"
+}
+
+func (d *APIKeyInventoryDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Inventory of the API keys of every active project of the organization, " +
+			"optionally including the admin API keys.",
+
+		Attributes: map[string]schema.Attribute{
+			"include_admin_keys": schema.BoolAttribute{
+				MarkdownDescription: "Whether to include the admin API keys of the organization. (Default: `false`)",
+				Optional:            true,
+			},
+			"concurrency": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf(
+					"The number of projects to read at the same time. (Default: `%d`)",
+					defaultAPIKeyInventoryConcurrency,
+				),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 16),
+				},
+			},
+			"api_keys": schema.ListNestedAttribute{
+				MarkdownDescription: "The API keys of the organization.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "The kind of the API key, either 'project' or 'admin'.",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the API key.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the API key.",
+							Computed:            true,
+						},
+						"redacted_value": schema.StringAttribute{
+							MarkdownDescription: "The redacted value of the API key.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "The timestamp when the API key was created.",
+							Computed:            true,
+						},
+						"last_used_at": schema.StringAttribute{
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "The timestamp when the API key was last used. Only reported for admin API keys.",
+							Computed:            true,
+						},
+						"age_days": schema.Int64Attribute{
+							MarkdownDescription: "The number of whole days since the API key was created.",
+							Computed:            true,
+						},
+						"project_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the project of the API key. Not set for admin API keys.",
+							Computed:            true,
+						},
+						"project_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the project of the API key. Not set for admin API keys.",
+							Computed:            true,
+						},
+						"owner_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the owner, e.g. 'user' or 'service_account'.",
+							Computed:            true,
+						},
+						"owner_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the owner.",
+							Computed:            true,
+						},
+						"owner_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the owner.",
+							Computed:            true,
+						},
+						"owner_email": schema.StringAttribute{
+							MarkdownDescription: "The email of the owner. Only set for keys of project users.",
+							Computed:            true,
+						},
+						"owner_role": schema.StringAttribute{
+							MarkdownDescription: "The role of the owner.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *APIKeyInventoryDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			))
+		return
+	}
+
+	d.client = client
+}
+
+func (d *APIKeyInventoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data APIKeyInventoryDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	concurrency := int64(defaultAPIKeyInventoryConcurrency)
+	if !data.Concurrency.IsNull() {
+		concurrency = data.Concurrency.ValueInt64()
+	}
+	now := time.Now()
+
+	projects, err := d.client.Projects.List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading projects list", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	// Every project is read by its own goroutine, writing only to its own slot to keep the order of the projects.
+	results := make([][]APIKeyInventoryItemModel, len(projects))
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(int(concurrency))
+	for i, project := range projects {
+		group.Go(func() error {
+			items, err := d.inventoryProject(groupCtx, project, now)
+			if err != nil {
+				return errors.Wrapf(err, "project %s", project.ID)
+			}
+			results[i] = items
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		resp.Diagnostics.AddError("Error reading project API keys", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	data.APIKeys = []APIKeyInventoryItemModel{}
+	for _, items := range results {
+		data.APIKeys = append(data.APIKeys, items...)
+	}
+
+	if data.IncludeAdminKeys.ValueBool() {
+		adminAPIKeys, err := d.client.AdminAPIKeys.List(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading admin API keys list", fmt.Sprintf("%+v", err))
+			return
+		}
+
+		for _, apiKey := range adminAPIKeys {
+			adminAPIKey := newAdminAPIKeyData(apiKey)
+			data.APIKeys = append(data.APIKeys, APIKeyInventoryItemModel{
+				Kind:          types.StringValue(apiKeyInventoryKindAdmin),
+				ID:            adminAPIKey.ID,
+				Name:          adminAPIKey.Name,
+				RedactedValue: adminAPIKey.RedactedValue,
+				CreatedAt:     adminAPIKey.CreatedAt,
+				LastUsedAt:    adminAPIKey.LastUsedAt,
+				AgeDays:       apiKeyAgeDays(apiKey.CreatedAt.Time, now),
+				ProjectID:     types.StringNull(),
+				ProjectName:   types.StringNull(),
+				OwnerType:     adminAPIKey.Owner.Type,
+				OwnerID:       adminAPIKey.Owner.ID,
+				OwnerName:     adminAPIKey.Owner.Name,
+				OwnerEmail:    types.StringNull(),
+				OwnerRole:     adminAPIKey.Owner.Role,
+			})
+		}
+	}
+
+	tflog.Trace(ctx, "Read API key inventory", map[string]any{
+		"projects": len(projects),
+		"api_keys": len(data.APIKeys),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// inventoryProject lists the API keys of the project, tagging the keys of service accounts
+// with the current details of the service account.
+func (d *APIKeyInventoryDataSource) inventoryProject(
+	ctx context.Context,
+	project openai.Project,
+	now time.Time,
+) ([]APIKeyInventoryItemModel, error) {
+	apiKeys, err := d.client.ProjectAPIKeys.List(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceAccounts, err := d.client.ProjectServiceAccounts.List(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	serviceAccountsByID := make(map[string]openai.ProjectServiceAccount, len(serviceAccounts))
+	for _, serviceAccount := range serviceAccounts {
+		serviceAccountsByID[serviceAccount.ID] = serviceAccount
+	}
+
+	items := make([]APIKeyInventoryItemModel, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		item := APIKeyInventoryItemModel{
+			Kind:          types.StringValue(apiKeyInventoryKindProject),
+			ID:            types.StringValue(apiKey.ID),
+			Name:          types.StringPointerValue(apiKey.Name),
+			RedactedValue: types.StringValue(apiKey.RedactedValue),
+			CreatedAt:     timetypes.NewRFC3339TimeValue(apiKey.CreatedAt.Time),
+			LastUsedAt:    timetypes.NewRFC3339Null(),
+			AgeDays:       apiKeyAgeDays(apiKey.CreatedAt.Time, now),
+			ProjectID:     types.StringValue(project.ID),
+			ProjectName:   types.StringValue(project.Name),
+			OwnerType:     types.StringValue(apiKey.Owner.Type),
+			OwnerID:       types.StringNull(),
+			OwnerName:     types.StringNull(),
+			OwnerEmail:    types.StringNull(),
+			OwnerRole:     types.StringNull(),
+		}
+
+		switch {
+		case apiKey.Owner.User != nil:
+			item.OwnerID = types.StringValue(apiKey.Owner.User.ID)
+			item.OwnerName = types.StringPointerValue(apiKey.Owner.User.Name)
+			item.OwnerEmail = types.StringValue(apiKey.Owner.User.Email)
+			item.OwnerRole = types.StringValue(string(apiKey.Owner.User.Role))
+		case apiKey.Owner.ServiceAccount != nil:
+			item.OwnerID = types.StringValue(apiKey.Owner.ServiceAccount.ID)
+			item.OwnerName = types.StringValue(apiKey.Owner.ServiceAccount.Name)
+			item.OwnerRole = types.StringValue(string(apiKey.Owner.ServiceAccount.Role))
+			if serviceAccount, ok := serviceAccountsByID[apiKey.Owner.ServiceAccount.ID]; ok {
+				item.OwnerName = types.StringValue(serviceAccount.Name)
+				item.OwnerRole = types.StringValue(string(serviceAccount.Role))
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func apiKeyAgeDays(createdAt, now time.Time) types.Int64 {
+	return types.Int64Value(int64(now.Sub(createdAt) / (24 * time.Hour)))
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAPIKeyInventoryDataSource(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	projectName := generateTestProject()
+	serviceAccountName := generateTestServiceAccount()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyInventoryDataSourceConfig(projectName, serviceAccountName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.openaiadmin_api_key_inventory.test", "api_keys.*", map[string]string{
+						"kind":         "project",
+						"project_name": projectName,
+						"owner_type":   "service_account",
+						"owner_name":   serviceAccountName,
+						"age_days":     "0",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.openaiadmin_api_key_inventory.test", "api_keys.*.id",
+						"openaiadmin_project_service_account.test", "api_key.id",
+					),
+					// The admin key used by the provider is included
+					resource.TestCheckTypeSetElemNestedAttrs("data.openaiadmin_api_key_inventory.test", "api_keys.*", map[string]string{
+						"kind": "admin",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccAPIKeyInventoryDataSourceConfig(projectName, serviceAccountName string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_project" "test" {
+  name = %[1]q
+}
+
+resource "openaiadmin_project_service_account" "test" {
+  project_id = openaiadmin_project.test.id
+  name       = %[2]q
+}
+
+data "openaiadmin_api_key_inventory" "test" {
+  include_admin_keys = true
+  concurrency        = 8
+
+  depends_on = [openaiadmin_project_service_account.test]
+}
+`, projectName, serviceAccountName)
+}
@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+type AuditLogsDataSource struct {
+	client openai.Client
+}
+
+type AuditLogProjectData struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+type AuditLogActorUserData struct {
+	ID    types.String `tfsdk:"id"`
+	Email types.String `tfsdk:"email"`
+}
+
+type AuditLogActorSessionData struct {
+	User      *AuditLogActorUserData `tfsdk:"user"`
+	IPAddress types.String           `tfsdk:"ip_address"`
+	UserAgent types.String           `tfsdk:"user_agent"`
+}
+
+type AuditLogActorAPIKeyData struct {
+	ID               types.String           `tfsdk:"id"`
+	Type             types.String           `tfsdk:"type"`
+	User             *AuditLogActorUserData `tfsdk:"user"`
+	ServiceAccountID types.String           `tfsdk:"service_account_id"`
+}
+
+type AuditLogActorData struct {
+	Type    types.String              `tfsdk:"type"`
+	Session *AuditLogActorSessionData `tfsdk:"session"`
+	APIKey  *AuditLogActorAPIKeyData  `tfsdk:"api_key"`
+}
+
+type AuditLogData struct {
+	ID          types.String         `tfsdk:"id"`
+	Type        types.String         `tfsdk:"type"`
+	EffectiveAt timetypes.RFC3339    `tfsdk:"effective_at"`
+	Project     *AuditLogProjectData `tfsdk:"project"`
+	Actor       AuditLogActorData    `tfsdk:"actor"`
+	Payload     types.String         `tfsdk:"payload"`
+}
+
+type AuditLogsDataSourceModel struct {
+	EffectiveAtGt  timetypes.RFC3339 `tfsdk:"effective_at_gt"`
+	EffectiveAtGte timetypes.RFC3339 `tfsdk:"effective_at_gte"`
+	EffectiveAtLt  timetypes.RFC3339 `tfsdk:"effective_at_lt"`
+	EffectiveAtLte timetypes.RFC3339 `tfsdk:"effective_at_lte"`
+	ProjectIDs     []types.String    `tfsdk:"project_ids"`
+	EventTypes     []types.String    `tfsdk:"event_types"`
+	ActorIDs       []types.String    `tfsdk:"actor_ids"`
+	ActorEmails    []types.String    `tfsdk:"actor_emails"`
+	ResourceIDs    []types.String    `tfsdk:"resource_ids"`
+	AuditLogs      []AuditLogData    `tfsdk:"audit_logs"`
+}
+
+func NewAuditLogsDataSource() datasource.DataSource {
+	return &AuditLogsDataSource{}
+}
+
+func (d *AuditLogsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_audit_logs"
+}
+
+func (d *AuditLogsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	actorUserAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the user.",
+			Computed:            true,
+		},
+		"email": schema.StringAttribute{
+			MarkdownDescription: "The email of the user.",
+			Computed:            true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Query the audit logs of the organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"effective_at_gt": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "Return only events that took effect after this time.",
+				Optional:            true,
+			},
+			"effective_at_gte": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "Return only events that took effect at or after this time.",
+				Optional:            true,
+			},
+			"effective_at_lt": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "Return only events that took effect before this time.",
+				Optional:            true,
+			},
+			"effective_at_lte": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "Return only events that took effect at or before this time.",
+				Optional:            true,
+			},
+			"project_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only events for these projects.",
+				Optional:            true,
+			},
+			"event_types": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only events with these types, e.g. `api_key.created`.",
+				Optional:            true,
+			},
+			"actor_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only events performed by these users or API keys.",
+				Optional:            true,
+			},
+			"actor_emails": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only events performed by users with these emails.",
+				Optional:            true,
+			},
+			"resource_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only events performed on these targets.",
+				Optional:            true,
+			},
+			"audit_logs": schema.ListNestedAttribute{
+				MarkdownDescription: "List of audit log events matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the event.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The event type.",
+							Computed:            true,
+						},
+						"effective_at": schema.StringAttribute{
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "The time the event took effect.",
+							Computed:            true,
+						},
+						"project": schema.SingleNestedAttribute{
+							MarkdownDescription: "The project the event belongs to, if any.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									MarkdownDescription: "The ID of the project.",
+									Computed:            true,
+								},
+								"name": schema.StringAttribute{
+									MarkdownDescription: "The name of the project.",
+									Computed:            true,
+								},
+							},
+						},
+						"actor": schema.SingleNestedAttribute{
+							MarkdownDescription: "The actor who performed the audited action.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									MarkdownDescription: "The type of the actor, either 'session' or 'api_key'.",
+									Computed:            true,
+								},
+								"session": schema.SingleNestedAttribute{
+									MarkdownDescription: "The session in which the audited action was performed.",
+									Computed:            true,
+									Attributes: map[string]schema.Attribute{
+										"user": schema.SingleNestedAttribute{
+											MarkdownDescription: "The user who performed the action.",
+											Computed:            true,
+											Attributes:          actorUserAttributes,
+										},
+										"ip_address": schema.StringAttribute{
+											MarkdownDescription: "The IP address from which the action was performed.",
+											Computed:            true,
+										},
+										"user_agent": schema.StringAttribute{
+											MarkdownDescription: "The user agent of the session.",
+											Computed:            true,
+										},
+									},
+								},
+								"api_key": schema.SingleNestedAttribute{
+									MarkdownDescription: "The API key that performed the audited action.",
+									Computed:            true,
+									Attributes: map[string]schema.Attribute{
+										"id": schema.StringAttribute{
+											MarkdownDescription: "The ID of the API key.",
+											Computed:            true,
+										},
+										"type": schema.StringAttribute{
+											MarkdownDescription: "The type of the API key owner, either 'user' or 'service_account'.",
+											Computed:            true,
+										},
+										"user": schema.SingleNestedAttribute{
+											MarkdownDescription: "The user who owns the API key.",
+											Computed:            true,
+											Attributes:          actorUserAttributes,
+										},
+										"service_account_id": schema.StringAttribute{
+											MarkdownDescription: "The ID of the service account that owns the API key.",
+											Computed:            true,
+										},
+									},
+								},
+							},
+						},
+						"payload": schema.StringAttribute{
+							MarkdownDescription: "The JSON-encoded event-specific payload. Use `jsondecode` to access it.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AuditLogsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			))
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AuditLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuditLogsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := openai.AuditLogFilter{
+		ProjectIDs:  stringValues(data.ProjectIDs),
+		EventTypes:  stringValues(data.EventTypes),
+		ActorIDs:    stringValues(data.ActorIDs),
+		ActorEmails: stringValues(data.ActorEmails),
+		ResourceIDs: stringValues(data.ResourceIDs),
+	}
+	filter.EffectiveAtGt = optionalTime(data.EffectiveAtGt, &resp.Diagnostics)
+	filter.EffectiveAtGte = optionalTime(data.EffectiveAtGte, &resp.Diagnostics)
+	filter.EffectiveAtLt = optionalTime(data.EffectiveAtLt, &resp.Diagnostics)
+	filter.EffectiveAtLte = optionalTime(data.EffectiveAtLte, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	auditLogs, err := d.client.AuditLogs.List(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading audit logs", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	data.AuditLogs = make([]AuditLogData, 0, len(auditLogs))
+	for _, auditLog := range auditLogs {
+		data.AuditLogs = append(data.AuditLogs, newAuditLogData(auditLog))
+	}
+
+	tflog.Trace(ctx, "Retrieved audit logs", map[string]interface{}{
+		"count": len(data.AuditLogs),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func newAuditLogData(auditLog openai.AuditLog) AuditLogData {
+	newUser := func(user *openai.AuditLogActorUser) *AuditLogActorUserData {
+		if user == nil {
+			return nil
+		}
+		return &AuditLogActorUserData{
+			ID:    types.StringValue(user.ID),
+			Email: types.StringValue(user.Email),
+		}
+	}
+
+	result := AuditLogData{
+		ID:          types.StringValue(auditLog.ID),
+		Type:        types.StringValue(auditLog.Type),
+		EffectiveAt: timetypes.NewRFC3339TimeValue(auditLog.EffectiveAt.Time),
+		Actor: AuditLogActorData{
+			Type: types.StringValue(auditLog.Actor.Type),
+		},
+		Payload: types.StringNull(),
+	}
+	if auditLog.Project != nil {
+		result.Project = &AuditLogProjectData{
+			ID:   types.StringValue(auditLog.Project.ID),
+			Name: types.StringValue(auditLog.Project.Name),
+		}
+	}
+	if session := auditLog.Actor.Session; session != nil {
+		result.Actor.Session = &AuditLogActorSessionData{
+			User:      newUser(&session.User),
+			IPAddress: types.StringValue(session.IPAddress),
+			UserAgent: types.StringValue(session.UserAgent),
+		}
+	}
+	if apiKey := auditLog.Actor.APIKey; apiKey != nil {
+		result.Actor.APIKey = &AuditLogActorAPIKeyData{
+			ID:               types.StringValue(apiKey.ID),
+			Type:             types.StringValue(apiKey.Type),
+			User:             newUser(apiKey.User),
+			ServiceAccountID: types.StringNull(),
+		}
+		if apiKey.ServiceAccount != nil {
+			result.Actor.APIKey.ServiceAccountID = types.StringValue(apiKey.ServiceAccount.ID)
+		}
+	}
+	if len(auditLog.Payload) > 0 {
+		result.Payload = types.StringValue(string(auditLog.Payload))
+	}
+
+	return result
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAuditLogsDataSource(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	since := time.Now().Add(-30 * 24 * time.Hour).UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuditLogsDataSourceConfig(since),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.openaiadmin_audit_logs.test", "audit_logs.#"),
+					resource.TestCheckResourceAttr("data.openaiadmin_audit_logs.test", "effective_at_gte", since),
+					resource.TestCheckResourceAttr("data.openaiadmin_audit_logs.test", "event_types.0", "project.created"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAuditLogsDataSourceConfig(since string) string {
+	return fmt.Sprintf(`
+data "openaiadmin_audit_logs" "test" {
+  effective_at_gte = %[1]q
+  event_types      = ["project.created"]
+}
+`, since)
+}
@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+type CostsDataSource struct {
+	client openai.Client
+}
+
+type CostResultData struct {
+	Amount    types.Float64 `tfsdk:"amount"`
+	Currency  types.String  `tfsdk:"currency"`
+	ProjectID types.String  `tfsdk:"project_id"`
+	LineItem  types.String  `tfsdk:"line_item"`
+}
+
+type CostBucketData struct {
+	StartTime timetypes.RFC3339 `tfsdk:"start_time"`
+	EndTime   timetypes.RFC3339 `tfsdk:"end_time"`
+	Results   []CostResultData  `tfsdk:"results"`
+}
+
+type CostsDataSourceModel struct {
+	StartTime   timetypes.RFC3339 `tfsdk:"start_time"`
+	EndTime     timetypes.RFC3339 `tfsdk:"end_time"`
+	BucketWidth types.String      `tfsdk:"bucket_width"`
+	ProjectIDs  []types.String    `tfsdk:"project_ids"`
+	GroupBy     []types.String    `tfsdk:"group_by"`
+	Buckets     []CostBucketData  `tfsdk:"buckets"`
+}
+
+func NewCostsDataSource() datasource.DataSource {
+	return &CostsDataSource{}
+}
+
+func (d *CostsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_costs"
+}
+
+func (d *CostsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve the costs of the organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"start_time": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "Start time of the query time range, inclusive.",
+				Required:            true,
+			},
+			"end_time": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "End time of the query time range, exclusive.",
+				Optional:            true,
+			},
+			"bucket_width": schema.StringAttribute{
+				MarkdownDescription: "Width of each time bucket. Currently only `1d` is supported. (Default: `1d`)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("1d"),
+				},
+			},
+			"project_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only costs for these projects.",
+				Optional:            true,
+			},
+			"group_by": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Group the costs by these fields. Any combination of `project_id` and `line_item`.",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("project_id", "line_item")),
+				},
+			},
+			"buckets": schema.ListNestedAttribute{
+				MarkdownDescription: "List of cost buckets in the time range.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start_time": schema.StringAttribute{
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "Start time of the bucket.",
+							Computed:            true,
+						},
+						"end_time": schema.StringAttribute{
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "End time of the bucket.",
+							Computed:            true,
+						},
+						"results": schema.ListNestedAttribute{
+							MarkdownDescription: "Aggregated costs within the bucket.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"amount": schema.Float64Attribute{
+										MarkdownDescription: "The cost amount.",
+										Computed:            true,
+									},
+									"currency": schema.StringAttribute{
+										MarkdownDescription: "The currency of the amount, e.g. `usd`.",
+										Computed:            true,
+									},
+									"project_id": schema.StringAttribute{
+										MarkdownDescription: "The ID of the project. Only set when grouped by `project_id`.",
+										Computed:            true,
+									},
+									"line_item": schema.StringAttribute{
+										MarkdownDescription: "The line item, e.g. a model name. Only set when grouped by `line_item`.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CostsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			))
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CostsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CostsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := openai.CostParams{
+		EndTime:     optionalTime(data.EndTime, &resp.Diagnostics),
+		BucketWidth: data.BucketWidth.ValueStringPointer(),
+		ProjectIDs:  stringValues(data.ProjectIDs),
+		GroupBy:     stringValues(data.GroupBy),
+	}
+	if startTime := optionalTime(data.StartTime, &resp.Diagnostics); startTime != nil {
+		params.StartTime = *startTime
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	buckets, err := d.client.Costs.List(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading costs", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	data.Buckets = make([]CostBucketData, 0, len(buckets))
+	for _, bucket := range buckets {
+		results := make([]CostResultData, 0, len(bucket.Results))
+		for _, result := range bucket.Results {
+			results = append(results, CostResultData{
+				Amount:    types.Float64Value(result.Amount.Value),
+				Currency:  types.StringValue(result.Amount.Currency),
+				ProjectID: types.StringPointerValue(result.ProjectID),
+				LineItem:  types.StringPointerValue(result.LineItem),
+			})
+		}
+
+		data.Buckets = append(data.Buckets, CostBucketData{
+			StartTime: timetypes.NewRFC3339TimeValue(bucket.StartTime.Time),
+			EndTime:   timetypes.NewRFC3339TimeValue(bucket.EndTime.Time),
+			Results:   results,
+		})
+	}
+
+	tflog.Trace(ctx, "Retrieved costs", map[string]interface{}{
+		"buckets": len(data.Buckets),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCostsDataSource(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	since := time.Now().Add(-7 * 24 * time.Hour).Truncate(24 * time.Hour).UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostsDataSourceConfig(since),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openaiadmin_costs.test", "start_time", since),
+					resource.TestCheckResourceAttr("data.openaiadmin_costs.test", "group_by.#", "2"),
+					resource.TestCheckResourceAttrSet("data.openaiadmin_costs.test", "buckets.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCostsDataSourceConfig(since string) string {
+	return fmt.Sprintf(`
+data "openaiadmin_costs" "test" {
+  start_time   = %[1]q
+  bucket_width = "1d"
+  group_by     = ["project_id", "line_item"]
+}
+`, since)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringValues converts a list of Terraform strings into plain strings, skipping null and unknown elements.
+func stringValues(values []types.String) []string {
+	var result []string
+	for _, value := range values {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		result = append(result, value.ValueString())
+	}
+	return result
+}
+
+// optionalTime converts an optional RFC3339 value into a time pointer, returning nil when it is not set.
+func optionalTime(value timetypes.RFC3339, diagnostics *diag.Diagnostics) *time.Time {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	t, diags := value.ValueRFC3339Time()
+	diagnostics.Append(diags...)
+	if diags.HasError() {
+		return nil
+	}
+	return &t
+}
+
+// timeRange is an optional half-open time range, including after and excluding before.
+type timeRange struct {
+	after  *time.Time
+	before *time.Time
+}
+
+// newTimeRange converts the optional bounds of a time range filter, leaving out the bounds that are not set.
+func newTimeRange(after, before timetypes.RFC3339, diagnostics *diag.Diagnostics) timeRange {
+	return timeRange{
+		after:  optionalTime(after, diagnostics),
+		before: optionalTime(before, diagnostics),
+	}
+}
+
+// contains reports whether t is within the range.
+func (r timeRange) contains(t time.Time) bool {
+	if r.after != nil && t.Before(*r.after) {
+		return false
+	}
+	if r.before != nil && !t.Before(*r.before) {
+		return false
+	}
+	return true
+}
+
+// listFilter holds the optional name_regex, created_after and created_before filters of the list data sources.
+type listFilter struct {
+	nameRegex *regexp.Regexp
+	created   timeRange
+}
+
+// newListFilter parses the name and creation time filters of a list data source.
+func newListFilter(
+	nameRegex types.String,
+	createdAfter, createdBefore timetypes.RFC3339,
+	diagnostics *diag.Diagnostics,
+) listFilter {
+	var filter listFilter
+	if !nameRegex.IsNull() && !nameRegex.IsUnknown() {
+		var err error
+		filter.nameRegex, err = regexp.Compile(nameRegex.ValueString())
+		if err != nil {
+			diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid regular expression", err.Error())
+		}
+	}
+	filter.created = newTimeRange(createdAfter, createdBefore, diagnostics)
+	return filter
+}
+
+// match reports whether an object with the given name and creation time passes the filter.
+// An object without a name never matches a name_regex.
+func (f listFilter) match(name *string, createdAt time.Time) bool {
+	if f.nameRegex != nil && (name == nil || !f.nameRegex.MatchString(*name)) {
+		return false
+	}
+	return f.created.contains(createdAt)
+}
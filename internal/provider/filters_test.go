@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListFilter(t *testing.T) {
+	createdAt := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	name := "test_project"
+
+	var diags diag.Diagnostics
+	unset := newListFilter(types.StringNull(), timetypes.NewRFC3339Null(), timetypes.NewRFC3339Null(), &diags)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.True(t, unset.match(&name, createdAt))
+	require.True(t, unset.match(nil, createdAt))
+
+	onlyBefore := newListFilter(
+		types.StringValue("^test_"),
+		timetypes.NewRFC3339Null(),
+		timetypes.NewRFC3339TimeValue(createdAt.Add(time.Hour)),
+		&diags,
+	)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.True(t, onlyBefore.match(&name, createdAt))
+	require.False(t, onlyBefore.match(nil, createdAt))
+	require.False(t, onlyBefore.match(&name, createdAt.Add(time.Hour)))
+
+	newListFilter(types.StringValue("("), timetypes.NewRFC3339Null(), timetypes.NewRFC3339Null(), &diags)
+	require.True(t, diags.HasError())
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+// findUserIDByEmail returns the ID of the organization user with the given email, ignoring case.
+// It reports false if no user has the email.
+func findUserIDByEmail(ctx context.Context, client openai.Client, email string) (string, bool, error) {
+	users, err := client.Users.List(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	userID, ok := userIDByEmail(users, email)
+	return userID, ok, nil
+}
+
+// userIDByEmail returns the ID of the user with the given email among users, ignoring case.
+// It reports false if no user has the email.
+func userIDByEmail(users []openai.User, email string) (string, bool) {
+	for _, user := range users {
+		if strings.EqualFold(user.Email, email) {
+			return user.ID, true
+		}
+	}
+	return "", false
+}
+
+// isSetKnown reports whether the set and all of its elements are known.
+func isSetKnown(value types.Set) bool {
+	if value.IsUnknown() {
+		return false
+	}
+	for _, element := range value.Elements() {
+		if element.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+// isMapKnown reports whether the map and all of its elements are known.
+func isMapKnown(value types.Map) bool {
+	if value.IsUnknown() {
+		return false
+	}
+	for _, element := range value.Elements() {
+		if element.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
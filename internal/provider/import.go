@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Prefixes of import IDs that are resolved to the ID of an object by looking it up.
+const (
+	importPrefixName        = "name:"
+	importPrefixEmail       = "email:"
+	importPrefixInviteEmail = "invite-email:"
+)
+
+// selectImportID returns the only ID among the candidates that matched the lookup of an import ID,
+// or an error if there is none or more than one.
+func selectImportID(kind, importID string, candidates []string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch len(candidates) {
+	case 0:
+		diags.AddError(
+			"Cannot import "+kind,
+			fmt.Sprintf("No %s matches the import ID %q.", kind, importID),
+		)
+		return "", diags
+	case 1:
+		return candidates[0], diags
+	default:
+		diags.AddError(
+			"Ambiguous import ID",
+			fmt.Sprintf(
+				"%d objects of type %s match the import ID %q: %s. Import one of them by ID instead.",
+				len(candidates),
+				kind,
+				importID,
+				strings.Join(candidates, ", "),
+			),
+		)
+		return "", diags
+	}
+}
@@ -19,13 +19,14 @@ type InviteDataSource struct {
 }
 
 type InviteDataSourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	Email      types.String `tfsdk:"email"`
-	Role       types.String `tfsdk:"role"`
-	Status     types.String `tfsdk:"status"`
-	InvitedAt  types.String `tfsdk:"invited_at"`
-	ExpiresAt  types.String `tfsdk:"expires_at"`
-	AcceptedAt types.String `tfsdk:"accepted_at"`
+	ID         types.String         `tfsdk:"id"`
+	Email      types.String         `tfsdk:"email"`
+	Role       types.String         `tfsdk:"role"`
+	Status     types.String         `tfsdk:"status"`
+	InvitedAt  types.String         `tfsdk:"invited_at"`
+	ExpiresAt  types.String         `tfsdk:"expires_at"`
+	AcceptedAt types.String         `tfsdk:"accepted_at"`
+	Projects   []InviteProjectModel `tfsdk:"projects"`
 }
 
 func NewInviteDataSource() datasource.DataSource {
@@ -73,6 +74,22 @@ func (d *InviteDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				MarkdownDescription: "The time the invite was accepted.",
 				Computed:            true,
 			},
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "The projects the invitee joins when accepting the invite.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the project.",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "The role of the invitee in the project.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -130,6 +147,7 @@ func (d *InviteDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	} else {
 		data.AcceptedAt = types.StringNull()
 	}
+	data.Projects = newInviteProjectModels(invite.Projects)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -6,13 +6,17 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,6 +27,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &InviteResource{}
 var _ resource.ResourceWithImportState = &InviteResource{}
+var _ resource.ResourceWithModifyPlan = &InviteResource{}
 
 func NewInviteResource() resource.Resource {
 	return &InviteResource{}
@@ -33,13 +38,21 @@ type InviteResource struct {
 }
 
 type InviteModel struct {
-	ID         types.String      `tfsdk:"id"`
-	Email      types.String      `tfsdk:"email"`
-	Role       types.String      `tfsdk:"role"`
-	Status     types.String      `tfsdk:"status"`
-	InvitedAt  timetypes.RFC3339 `tfsdk:"invited_at"`
-	ExpiresAt  timetypes.RFC3339 `tfsdk:"expires_at"`
-	AcceptedAt timetypes.RFC3339 `tfsdk:"accepted_at"`
+	ID               types.String         `tfsdk:"id"`
+	Email            types.String         `tfsdk:"email"`
+	Role             types.String         `tfsdk:"role"`
+	Status           types.String         `tfsdk:"status"`
+	InvitedAt        timetypes.RFC3339    `tfsdk:"invited_at"`
+	ExpiresAt        timetypes.RFC3339    `tfsdk:"expires_at"`
+	AcceptedAt       timetypes.RFC3339    `tfsdk:"accepted_at"`
+	Projects         []InviteProjectModel `tfsdk:"projects"`
+	ReinviteOnExpiry types.Bool           `tfsdk:"reinvite_on_expiry"`
+	UserID           types.String         `tfsdk:"user_id"`
+}
+
+type InviteProjectModel struct {
+	ID   types.String `tfsdk:"id"`
+	Role types.String `tfsdk:"role"`
 }
 
 func (r *InviteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -54,6 +67,9 @@ func (r *InviteResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the invite.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"email": schema.StringAttribute{
 				MarkdownDescription: "The email to invite.",
@@ -75,6 +91,9 @@ func (r *InviteResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			"status": schema.StringAttribute{
 				MarkdownDescription: "The status of the invite.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 				Validators: []validator.String{
 					stringvalidator.OneOf(
 						string(openai.InviteStatusPending),
@@ -87,16 +106,63 @@ func (r *InviteResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				MarkdownDescription: "The time the invite was created.",
 				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"expires_at": schema.StringAttribute{
 				MarkdownDescription: "The time the invite expires.",
 				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"accepted_at": schema.StringAttribute{
 				MarkdownDescription: "The time the invite was accepted.",
 				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reinvite_on_expiry": schema.BoolAttribute{
+				MarkdownDescription: "Whether to replace the invite with a new one once it has expired. (Default: `false`)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user who accepted the invite. Only set once the invite has been accepted.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"projects": schema.SetNestedAttribute{
+				MarkdownDescription: "The projects the invitee joins when accepting the invite.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the project.",
+							Required:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "The role of the invitee in the project.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(openai.ProjectUserRoleMember),
+									string(openai.ProjectUserRoleOwner),
+								),
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -121,6 +187,35 @@ func (r *InviteResource) Configure(_ context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
+func (r *InviteResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Re-inviting only applies to existing invites that are not being destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var reinviteOnExpiry types.Bool
+	var status types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("reinvite_on_expiry"), &reinviteOnExpiry)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("status"), &status)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !reinviteOnExpiry.ValueBool() || status.ValueString() != string(openai.InviteStatusExpired) {
+		return
+	}
+
+	tflog.Debug(ctx, "Replacing the expired invite", map[string]any{"status": status.ValueString()})
+	// Terraform only replaces a resource when the value at a RequiresReplace path changes,
+	// so mark the status of the new invite as unknown.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("status"))
+}
+
 func (r *InviteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data InviteModel
 
@@ -155,12 +250,21 @@ func (r *InviteResource) createInvite(ctx context.Context, data *InviteModel) er
 		return fmt.Errorf("role %s is not valid", data.Role.ValueString())
 	}
 
-	invite, err := r.client.Invites.Create(ctx, data.Email.ValueString(), role)
+	projects := make([]openai.InviteProject, 0, len(data.Projects))
+	for _, project := range data.Projects {
+		projects = append(projects, openai.InviteProject{
+			ID:   project.ID.ValueString(),
+			Role: openai.ProjectUserRole(project.Role.ValueString()),
+		})
+	}
+
+	invite, err := r.client.Invites.Create(ctx, data.Email.ValueString(), role, projects...)
 	if err != nil {
 		return err
 	}
 
 	data.ID = types.StringValue(invite.ID)
+	data.UserID = types.StringNull()
 	data.Status = types.StringValue(string(invite.Status))
 	data.InvitedAt = timetypes.NewRFC3339TimeValue(invite.InvitedAt.Time)
 	data.ExpiresAt = timetypes.NewRFC3339TimeValue(invite.ExpiresAt.Time)
@@ -201,6 +305,31 @@ func (r *InviteResource) Read(ctx context.Context, req resource.ReadRequest, res
 	} else {
 		data.AcceptedAt = timetypes.NewRFC3339Null()
 	}
+	// Keep the configured projects when the API does not report them, e.g. for imported invites.
+	if len(invite.Projects) > 0 {
+		data.Projects = newInviteProjectModels(invite.Projects)
+	}
+
+	// Imported invites have no settings yet, so fall back to the default.
+	if data.ReinviteOnExpiry.IsNull() {
+		data.ReinviteOnExpiry = types.BoolValue(false)
+	}
+
+	if invite.Status == openai.InviteStatusAccepted {
+		if data.UserID.IsNull() || data.UserID.IsUnknown() {
+			userID, ok, err := findUserIDByEmail(ctx, r.client, invite.Email)
+			if err != nil {
+				resp.Diagnostics.AddError("Error reading user", fmt.Sprintf("%+v", err))
+				return
+			}
+			data.UserID = types.StringNull()
+			if ok {
+				data.UserID = types.StringValue(userID)
+			}
+		}
+	} else {
+		data.UserID = types.StringNull()
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -213,15 +342,8 @@ func (r *InviteResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	if err := r.client.Invites.Delete(ctx, data.ID.ValueString()); err != nil && !openai.IsNotFoundError(err) {
-		resp.Diagnostics.AddError("Error deleting invite", fmt.Sprintf("%+v", err))
-		return
-	}
-
-	if err := r.createInvite(ctx, &data); err != nil {
-		resp.Diagnostics.AddError("Error creating invite", fmt.Sprintf("%+v", err))
-		return
-	}
+	// Every attribute of the invite itself requires replacement,
+	// so only the provider-side settings such as reinvite_on_expiry can change here.
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -248,5 +370,48 @@ func (r *InviteResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	inviteID := req.ID
+
+	if email, ok := strings.CutPrefix(req.ID, importPrefixInviteEmail); ok {
+		invites, err := r.client.Invites.List(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading invites", fmt.Sprintf("%+v", err))
+			return
+		}
+
+		var candidates, pending []string
+		for _, invite := range invites {
+			if !strings.EqualFold(invite.Email, email) {
+				continue
+			}
+			candidates = append(candidates, invite.ID)
+			if invite.Status == openai.InviteStatusPending {
+				pending = append(pending, invite.ID)
+			}
+		}
+		// Earlier invites of the same email have usually expired, so prefer the pending ones.
+		if len(pending) > 0 {
+			candidates = pending
+		}
+
+		var diags diag.Diagnostics
+		inviteID, diags = selectImportID("invite", req.ID, candidates)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), inviteID)...)
+}
+
+func newInviteProjectModels(projects []openai.InviteProject) []InviteProjectModel {
+	var result []InviteProjectModel
+	for _, project := range projects {
+		result = append(result, InviteProjectModel{
+			ID:   types.StringValue(project.ID),
+			Role: types.StringValue(string(project.Role)),
+		})
+	}
+	return result
 }
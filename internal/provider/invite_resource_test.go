@@ -53,6 +53,13 @@ func TestAccInviteResource_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import by email testing
+			{
+				ResourceName:      "openaiadmin_invite.test",
+				ImportState:       true,
+				ImportStateId:     "invite-email:" + email,
+				ImportStateVerify: true,
+			},
 			// Update (requires replace) testing
 			{
 				Config: testAccInviteResourceConfig(email, "owner"),
@@ -66,6 +73,88 @@ func TestAccInviteResource_basic(t *testing.T) {
 	})
 }
 
+func TestAccInviteResource_projects(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	email := generateTestEmail()
+	projectName := generateTestProject()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckInviteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInviteResourceConfigWithProject(email, projectName, "member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openaiadmin_invite.test", "projects.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"openaiadmin_invite.test", "projects.*.id",
+						"openaiadmin_project.test", "id",
+					),
+					resource.TestCheckTypeSetElemNestedAttrs("openaiadmin_invite.test", "projects.*", map[string]string{
+						"role": "member",
+					}),
+				),
+			},
+			// Changing the projects requires replace
+			{
+				Config: testAccInviteResourceConfigWithProject(email, projectName, "owner"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("openaiadmin_invite.test", "projects.*", map[string]string{
+						"role": "owner",
+					}),
+					resource.TestCheckResourceAttr("openaiadmin_invite.test", "status", "pending"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInviteResource_reinviteOnExpiry(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	email := generateTestEmail()
+	var previousInviteID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckInviteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInviteResourceConfig(email, "reader"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openaiadmin_invite.test", "reinvite_on_expiry", "false"),
+					resource.TestCheckNoResourceAttr("openaiadmin_invite.test", "user_id"),
+					resource.TestCheckResourceAttrWith("openaiadmin_invite.test", "id", func(value string) error {
+						previousInviteID = value
+						return nil
+					}),
+				),
+			},
+			// Enabling re-invites keeps the pending invite
+			{
+				Config: testAccInviteResourceConfigReinviteOnExpiry(email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openaiadmin_invite.test", "reinvite_on_expiry", "true"),
+					resource.TestCheckResourceAttr("openaiadmin_invite.test", "status", "pending"),
+					resource.TestCheckResourceAttrWith("openaiadmin_invite.test", "id", func(value string) error {
+						if value != previousInviteID {
+							return errors.Errorf("Invite %s was replaced by %s", previousInviteID, value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccInviteResource_externalDeletion(t *testing.T) {
 	if os.Getenv("ENV") == "local" {
 		t.Parallel()
@@ -197,6 +286,36 @@ resource "openaiadmin_invite" "test" {
 `, email, role)
 }
 
+func testAccInviteResourceConfigReinviteOnExpiry(email string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_invite" "test" {
+  email              = %[1]q
+  role               = "reader"
+  reinvite_on_expiry = true
+}
+`, email)
+}
+
+func testAccInviteResourceConfigWithProject(email, projectName, projectRole string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_project" "test" {
+  name = %[2]q
+}
+
+resource "openaiadmin_invite" "test" {
+  email = %[1]q
+  role  = "reader"
+
+  projects = [
+    {
+      id   = openaiadmin_project.test.id
+      role = %[3]q
+    },
+  ]
+}
+`, email, projectName, projectRole)
+}
+
 func testAccInviteResourceConfigMultiple(readerEmail, ownerEmail string) string {
 	return fmt.Sprintf(`
 resource "openaiadmin_invite" "reader" {
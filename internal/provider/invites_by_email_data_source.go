@@ -20,13 +20,14 @@ type InvitesByEmailDataSource struct {
 }
 
 type InviteData struct {
-	ID         types.String `tfsdk:"id"`
-	Email      types.String `tfsdk:"email"`
-	Role       types.String `tfsdk:"role"`
-	Status     types.String `tfsdk:"status"`
-	InvitedAt  types.String `tfsdk:"invited_at"`
-	ExpiresAt  types.String `tfsdk:"expires_at"`
-	AcceptedAt types.String `tfsdk:"accepted_at"`
+	ID         types.String         `tfsdk:"id"`
+	Email      types.String         `tfsdk:"email"`
+	Role       types.String         `tfsdk:"role"`
+	Status     types.String         `tfsdk:"status"`
+	InvitedAt  types.String         `tfsdk:"invited_at"`
+	ExpiresAt  types.String         `tfsdk:"expires_at"`
+	AcceptedAt types.String         `tfsdk:"accepted_at"`
+	Projects   []InviteProjectModel `tfsdk:"projects"`
 }
 
 func NewInvitesByEmailDataSource() datasource.DataSource {
@@ -84,6 +85,22 @@ func (d *InvitesByEmailDataSource) Schema(
 						"accepted_at": schema.StringAttribute{
 							Computed: true,
 						},
+						"projects": schema.ListNestedAttribute{
+							MarkdownDescription: "The projects the invitee joins when accepting the invite.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: "The ID of the project.",
+										Computed:            true,
+									},
+									"role": schema.StringAttribute{
+										MarkdownDescription: "The role of the invitee in the project.",
+										Computed:            true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -150,6 +167,7 @@ func (d *InvitesByEmailDataSource) Read(
 					}
 					return types.StringNull()
 				}(),
+				Projects: newInviteProjectModels(invite.Projects),
 			})
 		}
 	}
@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+// defaultInviteExpiryWindowDays is the number of days before expiry from which a pending invite expires soon.
+const defaultInviteExpiryWindowDays = 7
+
+var _ datasource.DataSource = &InvitesDataSource{}
+
+type InvitesDataSource struct {
+	client openai.Client
+}
+
+type InvitesDataSourceModel struct {
+	Status           types.String       `tfsdk:"status"`
+	Role             types.String       `tfsdk:"role"`
+	EmailDomain      types.String       `tfsdk:"email_domain"`
+	InvitedAfter     timetypes.RFC3339  `tfsdk:"invited_after"`
+	InvitedBefore    timetypes.RFC3339  `tfsdk:"invited_before"`
+	ExpiresAfter     timetypes.RFC3339  `tfsdk:"expires_after"`
+	ExpiresBefore    timetypes.RFC3339  `tfsdk:"expires_before"`
+	ExpiryWindowDays types.Int64        `tfsdk:"expiry_window_days"`
+	Invites          []InvitesItemModel `tfsdk:"invites"`
+}
+
+type InvitesItemModel struct {
+	ID          types.String         `tfsdk:"id"`
+	Email       types.String         `tfsdk:"email"`
+	Role        types.String         `tfsdk:"role"`
+	Status      types.String         `tfsdk:"status"`
+	InvitedAt   timetypes.RFC3339    `tfsdk:"invited_at"`
+	ExpiresAt   timetypes.RFC3339    `tfsdk:"expires_at"`
+	AcceptedAt  timetypes.RFC3339    `tfsdk:"accepted_at"`
+	ExpiresSoon types.Bool           `tfsdk:"expires_soon"`
+	Projects    []InviteProjectModel `tfsdk:"projects"`
+}
+
+func NewInvitesDataSource() datasource.DataSource {
+	return &InvitesDataSource{}
+}
+
+func (d *InvitesDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_invites"
+}
+
+func (d *InvitesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve the invites of the organization, optionally filtered.",
+
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return the invites with this status.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(openai.InviteStatusPending),
+						string(openai.InviteStatusAccepted),
+						string(openai.InviteStatusExpired),
+					),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Only return the invites with this role.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(openai.InviteRoleReader), string(openai.InviteRoleOwner)),
+				},
+			},
+			"email_domain": schema.StringAttribute{
+				MarkdownDescription: "Only return the invites whose email belongs to this domain, e.g. `example.com`. " +
+					"The comparison is case-insensitive.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"invited_after": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "Only return the invites sent at or after this time.",
+				Optional:            true,
+			},
+			"invited_before": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "Only return the invites sent before this time.",
+				Optional:            true,
+			},
+			"expires_after": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "Only return the invites expiring at or after this time.",
+				Optional:            true,
+			},
+			"expires_before": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "Only return the invites expiring before this time.",
+				Optional:            true,
+			},
+			"expiry_window_days": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf(
+					"The number of days before its expiry from which a pending invite is reported as `expires_soon`. "+
+						"(Default: `%d`)",
+					defaultInviteExpiryWindowDays,
+				),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"invites": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching invites.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the invite.",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email of the invitee.",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "The role of the invitee in the organization.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the invite.",
+							Computed:            true,
+						},
+						"invited_at": schema.StringAttribute{
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "The timestamp when the invite was sent.",
+							Computed:            true,
+						},
+						"expires_at": schema.StringAttribute{
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "The timestamp when the invite expires.",
+							Computed:            true,
+						},
+						"accepted_at": schema.StringAttribute{
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "The timestamp when the invite was accepted.",
+							Computed:            true,
+						},
+						"expires_soon": schema.BoolAttribute{
+							MarkdownDescription: "Whether the invite is pending and expires within `expiry_window_days`.",
+							Computed:            true,
+						},
+						"projects": schema.ListNestedAttribute{
+							MarkdownDescription: "The projects the invitee joins when accepting the invite.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: "The ID of the project.",
+										Computed:            true,
+									},
+									"role": schema.StringAttribute{
+										MarkdownDescription: "The role of the invitee in the project.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InvitesDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			))
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InvitesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InvitesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invited := newTimeRange(data.InvitedAfter, data.InvitedBefore, &resp.Diagnostics)
+	expires := newTimeRange(data.ExpiresAfter, data.ExpiresBefore, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expiryWindowDays := int64(defaultInviteExpiryWindowDays)
+	if !data.ExpiryWindowDays.IsNull() {
+		expiryWindowDays = data.ExpiryWindowDays.ValueInt64()
+	}
+	expiresSoonBefore := time.Now().Add(time.Duration(expiryWindowDays) * 24 * time.Hour)
+	emailDomain := strings.TrimPrefix(data.EmailDomain.ValueString(), "@")
+
+	invites, err := d.client.Invites.List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading invites list", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	data.Invites = make([]InvitesItemModel, 0, len(invites))
+	for _, invite := range invites {
+		if !data.Status.IsNull() && string(invite.Status) != data.Status.ValueString() {
+			continue
+		}
+		if !data.Role.IsNull() && string(invite.Role) != data.Role.ValueString() {
+			continue
+		}
+		if !data.EmailDomain.IsNull() {
+			_, domain, _ := strings.Cut(invite.Email, "@")
+			if !strings.EqualFold(domain, emailDomain) {
+				continue
+			}
+		}
+		if !invited.contains(invite.InvitedAt.Time) || !expires.contains(invite.ExpiresAt.Time) {
+			continue
+		}
+
+		item := InvitesItemModel{
+			ID:         types.StringValue(invite.ID),
+			Email:      types.StringValue(invite.Email),
+			Role:       types.StringValue(string(invite.Role)),
+			Status:     types.StringValue(string(invite.Status)),
+			InvitedAt:  timetypes.NewRFC3339TimeValue(invite.InvitedAt.Time),
+			ExpiresAt:  timetypes.NewRFC3339TimeValue(invite.ExpiresAt.Time),
+			AcceptedAt: timetypes.NewRFC3339Null(),
+			ExpiresSoon: types.BoolValue(
+				invite.Status == openai.InviteStatusPending && invite.ExpiresAt.Before(expiresSoonBefore),
+			),
+			Projects: newInviteProjectModels(invite.Projects),
+		}
+		if invite.AcceptedAt != nil {
+			item.AcceptedAt = timetypes.NewRFC3339TimeValue(invite.AcceptedAt.Time)
+		}
+		data.Invites = append(data.Invites, item)
+	}
+
+	tflog.Trace(ctx, "Read invites", map[string]interface{}{
+		"invites": len(data.Invites),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
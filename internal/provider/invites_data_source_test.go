@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInvitesDataSource(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	email := generateTestEmail()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvitesDataSourceConfig(email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.openaiadmin_invites.pending", "invites.*", map[string]string{
+						"email":        email,
+						"role":         "owner",
+						"status":       "pending",
+						"expires_soon": "true",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.openaiadmin_invites.pending", "invites.*.id",
+						"openaiadmin_invite.test", "id",
+					),
+					// The invite is filtered out by its domain
+					resource.TestCheckResourceAttr("data.openaiadmin_invites.other_domain", "invites.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInvitesDataSourceConfig(email string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_invite" "test" {
+  email = %[1]q
+  role  = "owner"
+}
+
+data "openaiadmin_invites" "pending" {
+  status             = "pending"
+  role               = "owner"
+  email_domain       = "EXAMPLE.com"
+  expiry_window_days = 365
+
+  depends_on = [openaiadmin_invite.test]
+}
+
+data "openaiadmin_invites" "other_domain" {
+  email_domain  = "example.org"
+  invited_after = openaiadmin_invite.test.invited_at
+}
+`, email)
+}
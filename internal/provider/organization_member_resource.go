@@ -0,0 +1,394 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OrganizationMemberResource{}
+var _ resource.ResourceWithImportState = &OrganizationMemberResource{}
+var _ resource.ResourceWithModifyPlan = &OrganizationMemberResource{}
+
+// The lifecycle states of an organization member.
+const (
+	organizationMemberStatusInvited = "invited"
+	organizationMemberStatusExpired = "expired"
+	organizationMemberStatusActive  = "active"
+)
+
+type OrganizationMemberResource struct {
+	client openai.Client
+}
+
+type OrganizationMemberModel struct {
+	Email    types.String `tfsdk:"email"`
+	Role     types.String `tfsdk:"role"`
+	Status   types.String `tfsdk:"status"`
+	UserID   types.String `tfsdk:"user_id"`
+	InviteID types.String `tfsdk:"invite_id"`
+}
+
+func NewOrganizationMemberResource() resource.Resource {
+	return &OrganizationMemberResource{}
+}
+
+func (r *OrganizationMemberResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_organization_member"
+}
+
+func (r *OrganizationMemberResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Organization member identified by email. " +
+			"If no user with the email belongs to the organization, an invite is sent, " +
+			"and the resource tracks the user once the invite has been accepted. " +
+			"Destroying this resource deletes the user, or cancels the invite if it is still pending.",
+
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email of the member.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The role of the member in the organization.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(openai.UserRoleReader), string(openai.UserRoleOwner)),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the membership. `invited` while the invite is pending, " +
+					"`expired` when the invite expired before it was accepted, and `active` once the user joined. " +
+					"An expired invite is sent again by the next apply.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user. Only set once the member has joined the organization.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invite_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the invite. Only set until the member has joined the organization.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationMemberResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationMemberResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Only existing members that are not being destroyed may need a new invite.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state OrganizationMemberModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The role of a user is modified in place, so only members who have not joined yet get a new invite.
+	if state.Status.ValueString() == organizationMemberStatusActive {
+		return
+	}
+	if state.Status.ValueString() != organizationMemberStatusExpired && plan.Role.Equal(state.Role) {
+		return
+	}
+
+	tflog.Debug(ctx, "Re-inviting the organization member", map[string]any{"status": state.Status.ValueString()})
+	// The member may also accept the current invite before the apply, so the outcome is only known after it.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("user_id"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("invite_id"), types.StringUnknown())...)
+}
+
+func (r *OrganizationMemberResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data OrganizationMemberModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, invite, err := r.lookup(ctx, data.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization member", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	if err := r.reconcile(ctx, &data, user, invite); err != nil {
+		resp.Diagnostics.AddError("Error creating organization member", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Created an Organization Member resource", map[string]any{
+		"email":  data.Email.ValueString(),
+		"status": data.Status.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationMemberModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, invite, err := r.lookup(ctx, data.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization member", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	switch {
+	case user != nil:
+		setOrganizationMemberUser(&data, user)
+	case invite != nil && invite.Status != openai.InviteStatusAccepted:
+		setOrganizationMemberInvite(&data, invite)
+	default:
+		// Neither a user nor an open invite exists, e.g. the user left the organization.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMemberResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data OrganizationMemberModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, invite, err := r.lookup(ctx, data.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization member", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	if err := r.reconcile(ctx, &data, user, invite); err != nil {
+		resp.Diagnostics.AddError("Error updating organization member", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMemberResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data OrganizationMemberModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Look the member up again, as the invite may have been accepted since the last refresh.
+	user, invite, err := r.lookup(ctx, data.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization member", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	if user != nil {
+		if err := r.client.Users.Delete(ctx, user.ID); err != nil && !openai.IsNotFoundError(err) {
+			resp.Diagnostics.AddError("Error deleting user", fmt.Sprintf("%+v", err))
+			return
+		}
+	} else if invite != nil && invite.Status == openai.InviteStatusPending {
+		if err := r.client.Invites.Delete(ctx, invite.ID); err != nil && !openai.IsNotFoundError(err) {
+			resp.Diagnostics.AddError("Error deleting invite", fmt.Sprintf("%+v", err))
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *OrganizationMemberResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("email"), req, resp)
+}
+
+// lookup finds the user and the most relevant invite with the given email.
+// A pending invite takes precedence over expired or accepted ones.
+func (r *OrganizationMemberResource) lookup(
+	ctx context.Context,
+	email string,
+) (*openai.User, *openai.Invite, error) {
+	userID, ok, err := findUserIDByEmail(ctx, r.client, email)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ok {
+		user, err := r.client.Users.Retrieve(ctx, userID)
+		if err == nil {
+			return user, nil, nil
+		}
+		// The user may have left the organization since it was listed.
+		if !openai.IsNotFoundError(err) {
+			return nil, nil, err
+		}
+	}
+
+	invites, err := r.client.Invites.List(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var found *openai.Invite
+	for _, invite := range invites {
+		if !strings.EqualFold(invite.Email, email) {
+			continue
+		}
+		if found == nil ||
+			invite.Status == openai.InviteStatusPending && found.Status != openai.InviteStatusPending ||
+			invite.Status == found.Status && invite.InvitedAt.After(found.InvitedAt.Time) {
+			found = &invite
+		}
+	}
+
+	return nil, found, nil
+}
+
+// reconcile makes the organization match the planned role,
+// modifying the existing user or (re-)sending the invite as needed.
+func (r *OrganizationMemberResource) reconcile(
+	ctx context.Context,
+	data *OrganizationMemberModel,
+	user *openai.User,
+	invite *openai.Invite,
+) error {
+	role := openai.UserRole(data.Role.ValueString())
+
+	if user != nil {
+		if user.Role != role {
+			modified, err := r.client.Users.Modify(ctx, user.ID, role)
+			if err != nil {
+				return err
+			}
+			user = modified
+		}
+		setOrganizationMemberUser(data, user)
+		return nil
+	}
+
+	if invite != nil && invite.Status == openai.InviteStatusPending {
+		if invite.Role == openai.InviteRole(role) {
+			setOrganizationMemberInvite(data, invite)
+			return nil
+		}
+		// The role of an invite cannot be changed, so replace it.
+		if err := r.client.Invites.Delete(ctx, invite.ID); err != nil && !openai.IsNotFoundError(err) {
+			return err
+		}
+	}
+
+	created, err := r.client.Invites.Create(ctx, data.Email.ValueString(), openai.InviteRole(role))
+	if err != nil {
+		return err
+	}
+	setOrganizationMemberInvite(data, created)
+
+	return nil
+}
+
+func setOrganizationMemberUser(data *OrganizationMemberModel, user *openai.User) {
+	data.Role = types.StringValue(string(user.Role))
+	data.Status = types.StringValue(organizationMemberStatusActive)
+	data.UserID = types.StringValue(user.ID)
+	data.InviteID = types.StringNull()
+}
+
+func setOrganizationMemberInvite(data *OrganizationMemberModel, invite *openai.Invite) {
+	data.Role = types.StringValue(string(invite.Role))
+	data.Status = types.StringValue(organizationMemberStatusInvited)
+	if invite.Status == openai.InviteStatusExpired {
+		data.Status = types.StringValue(organizationMemberStatusExpired)
+	}
+	data.UserID = types.StringNull()
+	data.InviteID = types.StringValue(invite.ID)
+}
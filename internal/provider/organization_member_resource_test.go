@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+	"github.com/pkg/errors"
+)
+
+func TestAccOrganizationMemberResource_invite(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	email := generateTestEmail()
+	resourceName := "openaiadmin_organization_member.test"
+	var previousInviteID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationMemberDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationMemberResourceConfig(email, "reader"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "email", email),
+					resource.TestCheckResourceAttr(resourceName, "role", "reader"),
+					resource.TestCheckResourceAttr(resourceName, "status", "invited"),
+					resource.TestCheckNoResourceAttr(resourceName, "user_id"),
+					resource.TestCheckResourceAttrWith(resourceName, "invite_id", func(value string) error {
+						previousInviteID = value
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        email,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "email",
+			},
+			// Changing the role of a pending member re-sends the invite
+			{
+				Config: testAccOrganizationMemberResourceConfig(email, "owner"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "role", "owner"),
+					resource.TestCheckResourceAttr(resourceName, "status", "invited"),
+					resource.TestCheckResourceAttrWith(resourceName, "invite_id", func(value string) error {
+						if value == previousInviteID {
+							return errors.Errorf("Invite %s was not re-sent", value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationMemberResource_disappears(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	email := generateTestEmail()
+	client := openai.NewSDKClient(os.Getenv("OPENAI_ADMIN_TOKEN"), nil)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationMemberResourceConfig(email, "reader"),
+				Check: func(s *terraform.State) error {
+					rs, ok := s.RootModule().Resources["openaiadmin_organization_member.test"]
+					if !ok {
+						return errors.New("Organization member not found in state")
+					}
+					return client.Invites.Delete(context.Background(), rs.Primary.Attributes["invite_id"])
+				},
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationMemberDestroy(s *terraform.State) error {
+	client := openai.NewSDKClient(os.Getenv("OPENAI_ADMIN_TOKEN"), nil)
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openaiadmin_organization_member" {
+			continue
+		}
+
+		invites, err := client.Invites.List(ctx)
+		if err != nil {
+			return errors.Wrap(err, "Error listing invites")
+		}
+
+		inviteID := rs.Primary.Attributes["invite_id"]
+		exists := slices.ContainsFunc(invites, func(i openai.Invite) bool {
+			return i.ID == inviteID && i.Status == openai.InviteStatusPending
+		})
+		if exists {
+			return errors.Errorf("Invite %s still exists", inviteID)
+		}
+	}
+
+	return nil
+}
+
+func testAccOrganizationMemberResourceConfig(email, role string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_organization_member" "test" {
+  email = %[1]q
+  role  = %[2]q
+}
+`, email, role)
+}
@@ -0,0 +1,610 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OrganizationUsersExclusiveResource{}
+var _ resource.ResourceWithImportState = &OrganizationUsersExclusiveResource{}
+var _ resource.ResourceWithModifyPlan = &OrganizationUsersExclusiveResource{}
+
+type OrganizationUsersExclusiveResource struct {
+	client openai.Client
+}
+
+type OrganizationUsersExclusiveModel struct {
+	Members            types.Map `tfsdk:"members"`
+	Protected          types.Set `tfsdk:"protected"`
+	Users              types.Map `tfsdk:"users"`
+	PendingInvites     types.Map `tfsdk:"pending_invites"`
+	RemovedUserIDs     types.Set `tfsdk:"removed_user_ids"`
+	CancelledInviteIDs types.Set `tfsdk:"cancelled_invite_ids"`
+}
+
+// organizationRoster is the role of every user and pending invite of the organization, keyed by lowercase email.
+type organizationRoster struct {
+	users          map[string]string
+	pendingInvites map[string]string
+}
+
+func NewOrganizationUsersExclusiveResource() resource.Resource {
+	return &OrganizationUsersExclusiveResource{}
+}
+
+func (r *OrganizationUsersExclusiveResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_organization_users_exclusive"
+}
+
+func (r *OrganizationUsersExclusiveResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	roles := []string{string(openai.UserRoleReader), string(openai.UserRoleOwner)}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritative roster of the users of the organization. " +
+			"Every apply invites the `members` that have not joined yet, updates the roles of the existing users, " +
+			"and deletes the users and cancels the pending invites that are neither listed in `members` nor `protected`. " +
+			"Only the users and invites listed in `removed_user_ids` and `cancelled_invite_ids` of the plan are removed; " +
+			"users who join or are invited after planning are left for the next apply. " +
+			"Destroying this resource only removes it from the Terraform state and does not delete any user. " +
+			"Importing it with any ID, e.g. `organization`, adopts the organization as is: " +
+			"every current user and pending invite becomes a member with its current role.",
+
+		Attributes: map[string]schema.Attribute{
+			"members": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The role of every member of the organization, keyed by email.",
+				Required:            true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(regexp.MustCompile(`@`), "must be an email")),
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf(roles...)),
+				},
+			},
+			"protected": schema.SetAttribute{
+				ElementType: types.StringType,
+				MarkdownDescription: "The IDs or emails of users, e.g. break-glass owners, " +
+					"that are never modified or deleted even though they are not listed in `members`.",
+				Optional: true,
+			},
+			"users": schema.MapAttribute{
+				ElementType: types.StringType,
+				MarkdownDescription: "The role of every user of the organization, keyed by lowercase email. " +
+					"Users that will be deleted show up as removals in the plan.",
+				Computed: true,
+			},
+			"pending_invites": schema.MapAttribute{
+				ElementType: types.StringType,
+				MarkdownDescription: "The role of every pending invite, keyed by lowercase email. " +
+					"Invites that will be sent or cancelled show up in the plan.",
+				Computed: true,
+			},
+			"removed_user_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				MarkdownDescription: "The IDs of the users deleted by the apply. " +
+					"Unknown in the plan when the users cannot be listed until the apply, e.g. when `members` is unknown. " +
+					"Reset to empty by the next refresh.",
+				Computed: true,
+			},
+			"cancelled_invite_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				MarkdownDescription: "The IDs of the pending invites cancelled by the apply, " +
+					"including the invites of members that are re-sent with a new role. " +
+					"Unknown in the plan when the invites cannot be listed until the apply, e.g. when `members` is unknown. " +
+					"Reset to empty by the next refresh.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *OrganizationUsersExclusiveResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationUsersExclusiveResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data OrganizationUsersExclusiveModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.Users == nil || r.client.Invites == nil || !isMapKnown(data.Members) || !isSetKnown(data.Protected) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("users"), types.MapUnknown(types.StringType))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(
+			ctx,
+			path.Root("pending_invites"),
+			types.MapUnknown(types.StringType),
+		)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(
+			ctx,
+			path.Root("removed_user_ids"),
+			types.SetUnknown(types.StringType),
+		)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(
+			ctx,
+			path.Root("cancelled_invite_ids"),
+			types.SetUnknown(types.StringType),
+		)...)
+		return
+	}
+
+	users, invites, err := r.list(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization users", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	members, protected, diags := organizationUsersExclusiveSettings(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The apply removes exactly the users and invites listed here,
+	// so that users who join or are invited after planning are never removed unseen.
+	removedUsers, cancelledInvites := plannedOrganizationRemovals(users, invites, members, protected)
+	resp.Diagnostics.Append(setOrganizationRemovals(ctx, &data, removedUsers, cancelledInvites)...)
+	roster := plannedOrganizationRoster(users, invites, members, protected)
+	resp.Diagnostics.Append(setOrganizationRoster(ctx, &data, roster)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("users"), data.Users)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pending_invites"), data.PendingInvites)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("removed_user_ids"), data.RemovedUserIDs)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cancelled_invite_ids"), data.CancelledInviteIDs)...)
+
+	if len(removedUsers) > 0 {
+		removed := make([]string, 0, len(removedUsers))
+		for _, user := range removedUsers {
+			removed = append(removed, fmt.Sprintf("%s (%s)", user.Email, user.ID))
+		}
+		sort.Strings(removed)
+		resp.Diagnostics.AddWarning(
+			"Organization users will be deleted",
+			"Applying this plan deletes the following users from the organization: "+strings.Join(removed, ", "),
+		)
+	}
+	if len(cancelledInvites) > 0 {
+		cancelled := make([]string, 0, len(cancelledInvites))
+		for _, invite := range cancelledInvites {
+			cancelled = append(cancelled, fmt.Sprintf("%s (%s)", invite.Email, invite.ID))
+		}
+		sort.Strings(cancelled)
+		resp.Diagnostics.AddWarning(
+			"Organization invites will be cancelled",
+			"Applying this plan cancels the following pending invites: "+strings.Join(cancelled, ", "),
+		)
+	}
+}
+
+func (r *OrganizationUsersExclusiveResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data OrganizationUsersExclusiveModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created an Organization Users Exclusive resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationUsersExclusiveResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data OrganizationUsersExclusiveModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, invites, err := r.list(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization users", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	roster := organizationRoster{
+		users:          make(map[string]string, len(users)),
+		pendingInvites: make(map[string]string, len(invites)),
+	}
+	for _, user := range users {
+		roster.users[strings.ToLower(user.Email)] = string(user.Role)
+	}
+	for _, invite := range invites {
+		roster.pendingInvites[strings.ToLower(invite.Email)] = string(invite.Role)
+	}
+
+	resp.Diagnostics.Append(setOrganizationRoster(ctx, &data, roster)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.RemovedUserIDs = types.SetValueMust(types.StringType, nil)
+	data.CancelledInviteIDs = types.SetValueMust(types.StringType, nil)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationUsersExclusiveResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data OrganizationUsersExclusiveModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationUsersExclusiveResource) Delete(
+	ctx context.Context,
+	_ resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	// Users are only deleted while the roster is managed, so there is nothing to delete.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *OrganizationUsersExclusiveResource) ImportState(
+	ctx context.Context,
+	_ resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	users, invites, err := r.list(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization users", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	// Users take precedence over the invites sent to the same email.
+	members := make(map[string]string, len(users)+len(invites))
+	for _, invite := range invites {
+		members[strings.ToLower(invite.Email)] = string(invite.Role)
+	}
+	for _, user := range users {
+		members[strings.ToLower(user.Email)] = string(user.Role)
+	}
+
+	value, diags := types.MapValueFrom(ctx, types.StringType, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("members"), value)...)
+}
+
+// list returns the users and the pending invites of the organization.
+func (r *OrganizationUsersExclusiveResource) list(ctx context.Context) ([]openai.User, []openai.Invite, error) {
+	users, err := r.client.Users.List(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	invites, err := r.client.Invites.List(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return users, slices.DeleteFunc(invites, func(invite openai.Invite) bool {
+		return invite.Status != openai.InviteStatusPending
+	}), nil
+}
+
+// reconcile invites and modifies users until the organization matches the model,
+// and deletes the users and cancels the invites planned in removed_user_ids and cancelled_invite_ids.
+// The removals are only looked up now if they were not known while planning.
+func (r *OrganizationUsersExclusiveResource) reconcile(
+	ctx context.Context,
+	data *OrganizationUsersExclusiveModel,
+) diag.Diagnostics {
+	members, protected, diags := organizationUsersExclusiveSettings(ctx, data)
+	if diags.HasError() {
+		return diags
+	}
+
+	users, invites, err := r.list(ctx)
+	if err != nil {
+		diags.AddError("Error reading organization users", fmt.Sprintf("%+v", err))
+		return diags
+	}
+
+	if data.RemovedUserIDs.IsUnknown() || data.CancelledInviteIDs.IsUnknown() {
+		removedUsers, cancelledInvites := plannedOrganizationRemovals(users, invites, members, protected)
+		diags.Append(setOrganizationRemovals(ctx, data, removedUsers, cancelledInvites)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	var removedUserIDs, cancelledInviteIDs []string
+	diags.Append(data.RemovedUserIDs.ElementsAs(ctx, &removedUserIDs, false)...)
+	diags.Append(data.CancelledInviteIDs.ElementsAs(ctx, &cancelledInviteIDs, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	joined := make(map[string]bool, len(users))
+	for _, user := range users {
+		email := strings.ToLower(user.Email)
+		joined[email] = true
+
+		if role, ok := members[email]; ok && user.Role != openai.UserRole(role) {
+			if _, err := r.client.Users.Modify(ctx, user.ID, openai.UserRole(role)); err != nil {
+				diags.AddError("Error updating user", fmt.Sprintf("%+v", err))
+				return diags
+			}
+		}
+	}
+
+	for _, userID := range removedUserIDs {
+		if err := r.client.Users.Delete(ctx, userID); err != nil && !openai.IsNotFoundError(err) {
+			diags.AddError("Error deleting user", fmt.Sprintf("%+v", err))
+			return diags
+		}
+		tflog.Debug(ctx, "Deleted unmanaged organization user", map[string]any{"id": userID})
+	}
+
+	cancelled := make(map[string]bool, len(cancelledInviteIDs))
+	for _, inviteID := range cancelledInviteIDs {
+		if err := r.client.Invites.Delete(ctx, inviteID); err != nil && !openai.IsNotFoundError(err) {
+			diags.AddError("Error deleting invite", fmt.Sprintf("%+v", err))
+			return diags
+		}
+		cancelled[inviteID] = true
+		tflog.Debug(ctx, "Cancelled organization invite", map[string]any{"id": inviteID})
+	}
+
+	invited := make(map[string]bool, len(invites))
+	for _, invite := range invites {
+		email := strings.ToLower(invite.Email)
+		if role, ok := members[email]; ok && !cancelled[invite.ID] && invite.Role == openai.InviteRole(role) {
+			invited[email] = true
+		}
+	}
+
+	for email, role := range members {
+		if joined[email] || invited[email] {
+			continue
+		}
+		if _, err := r.client.Invites.Create(ctx, email, openai.InviteRole(role)); err != nil {
+			diags.AddError("Error creating invite", fmt.Sprintf("%+v", err))
+			return diags
+		}
+	}
+
+	// The state keeps the roster planned from the organization as it was while planning, since it must match the
+	// plan. Users that joined in the meantime show up on the next refresh.
+	if data.Users.IsUnknown() || data.PendingInvites.IsUnknown() {
+		diags.Append(setOrganizationRoster(ctx, data, plannedOrganizationRoster(users, invites, members, protected))...)
+	}
+	return diags
+}
+
+// organizationUsersExclusiveSettings returns the members keyed by lowercase email,
+// and the lowercase IDs and emails of the protected users.
+func organizationUsersExclusiveSettings(
+	ctx context.Context,
+	data *OrganizationUsersExclusiveModel,
+) (map[string]string, map[string]bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var configured map[string]string
+	var protectedList []string
+	diags.Append(data.Members.ElementsAs(ctx, &configured, false)...)
+	diags.Append(data.Protected.ElementsAs(ctx, &protectedList, false)...)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	members := make(map[string]string, len(configured))
+	for email, role := range configured {
+		if _, ok := members[strings.ToLower(email)]; ok {
+			diags.AddAttributeError(
+				path.Root("members").AtMapKey(email),
+				"Duplicate member",
+				fmt.Sprintf("Email %s is listed more than once.", email),
+			)
+			continue
+		}
+		members[strings.ToLower(email)] = role
+	}
+
+	protected := make(map[string]bool, len(protectedList))
+	for _, value := range protectedList {
+		protected[strings.ToLower(value)] = true
+	}
+
+	return members, protected, diags
+}
+
+func isProtectedUser(protected map[string]bool, user openai.User) bool {
+	return protected[strings.ToLower(user.ID)] || protected[strings.ToLower(user.Email)]
+}
+
+// plannedOrganizationRoster returns the roster of the organization after reconciling the given users and invites.
+func plannedOrganizationRoster(
+	users []openai.User,
+	invites []openai.Invite,
+	members map[string]string,
+	protected map[string]bool,
+) organizationRoster {
+	roster := organizationRoster{
+		users:          make(map[string]string, len(members)),
+		pendingInvites: make(map[string]string, len(members)),
+	}
+
+	for _, user := range users {
+		email := strings.ToLower(user.Email)
+		if role, ok := members[email]; ok {
+			roster.users[email] = role
+		} else if isProtectedUser(protected, user) {
+			roster.users[email] = string(user.Role)
+		}
+	}
+	for _, invite := range invites {
+		email := strings.ToLower(invite.Email)
+		if _, ok := members[email]; !ok && protected[email] {
+			roster.pendingInvites[email] = string(invite.Role)
+		}
+	}
+	for email, role := range members {
+		if _, ok := roster.users[email]; !ok {
+			roster.pendingInvites[email] = role
+		}
+	}
+
+	return roster
+}
+
+// plannedOrganizationRemovals returns the users that are deleted and the invites that are cancelled
+// when reconciling the given users and invites.
+// The role of an invite cannot be changed, so unmanaged and outdated invites are cancelled.
+func plannedOrganizationRemovals(
+	users []openai.User,
+	invites []openai.Invite,
+	members map[string]string,
+	protected map[string]bool,
+) ([]openai.User, []openai.Invite) {
+	var removedUsers []openai.User
+	joined := make(map[string]bool, len(users))
+	for _, user := range users {
+		email := strings.ToLower(user.Email)
+		joined[email] = true
+		if _, ok := members[email]; !ok && !isProtectedUser(protected, user) {
+			removedUsers = append(removedUsers, user)
+		}
+	}
+
+	var cancelledInvites []openai.Invite
+	for _, invite := range invites {
+		email := strings.ToLower(invite.Email)
+		if role, ok := members[email]; ok && !joined[email] && invite.Role == openai.InviteRole(role) {
+			continue
+		}
+		if protected[email] {
+			continue
+		}
+		cancelledInvites = append(cancelledInvites, invite)
+	}
+
+	return removedUsers, cancelledInvites
+}
+
+func setOrganizationRoster(
+	ctx context.Context,
+	data *OrganizationUsersExclusiveModel,
+	roster organizationRoster,
+) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	data.Users, d = types.MapValueFrom(ctx, types.StringType, roster.users)
+	diags.Append(d...)
+	data.PendingInvites, d = types.MapValueFrom(ctx, types.StringType, roster.pendingInvites)
+	diags.Append(d...)
+
+	return diags
+}
+
+func setOrganizationRemovals(
+	ctx context.Context,
+	data *OrganizationUsersExclusiveModel,
+	removedUsers []openai.User,
+	cancelledInvites []openai.Invite,
+) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	removedUserIDs := make([]string, 0, len(removedUsers))
+	for _, user := range removedUsers {
+		removedUserIDs = append(removedUserIDs, user.ID)
+	}
+	cancelledInviteIDs := make([]string, 0, len(cancelledInvites))
+	for _, invite := range cancelledInvites {
+		cancelledInviteIDs = append(cancelledInviteIDs, invite.ID)
+	}
+
+	data.RemovedUserIDs, d = types.SetValueFrom(ctx, types.StringType, removedUserIDs)
+	diags.Append(d...)
+	data.CancelledInviteIDs, d = types.SetValueFrom(ctx, types.StringType, cancelledInviteIDs)
+	diags.Append(d...)
+
+	return diags
+}
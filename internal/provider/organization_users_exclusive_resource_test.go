@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// The roster is organization wide and cancels unmanaged invites, so this test never runs in parallel.
+func TestAccOrganizationUsersExclusiveResource(t *testing.T) {
+	email := generateTestEmail()
+	resourceName := "openaiadmin_organization_users_exclusive.test"
+	client := openai.NewSDKClient(os.Getenv("OPENAI_ADMIN_TOKEN"), nil)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			// Destroying the resource keeps the invite it sent
+			t.Cleanup(func() { testAccDeletePendingInvites(t, client, email) })
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Existing users are protected, so the only change is the invite of the new member
+			{
+				Config: testAccOrganizationUsersExclusiveResourceConfig(email, "reader"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members."+email, "reader"),
+					resource.TestCheckResourceAttr(resourceName, "pending_invites."+email, "reader"),
+					resource.TestCheckNoResourceAttr(resourceName, "users."+email),
+					resource.TestCheckResourceAttr(resourceName, "removed_user_ids.#", "0"),
+				),
+			},
+			// Changing the role of a pending member cancels the invite and re-sends it
+			{
+				Config: testAccOrganizationUsersExclusiveResourceConfig(email, "owner"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "pending_invites."+email, "owner"),
+					resource.TestCheckResourceAttr(resourceName, "removed_user_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "cancelled_invite_ids.#", "1"),
+				),
+			},
+			// Importing adopts every user and pending invite as a member
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "organization",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return errors.Errorf("expected 1 imported resource, got %d", len(states))
+					}
+					if role := states[0].Attributes["members."+email]; role != "owner" {
+						return errors.Errorf("expected member %s to be an owner, got %q", email, role)
+					}
+					if role := states[0].Attributes["pending_invites."+email]; role != "owner" {
+						return errors.Errorf("expected pending invite of %s to be an owner, got %q", email, role)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccOrganizationUsersExclusiveResourceConfig(email, role string) string {
+	return fmt.Sprintf(`
+data "openaiadmin_users_list" "all" {}
+
+resource "openaiadmin_organization_users_exclusive" "test" {
+  members = {
+    %[1]q = %[2]q
+  }
+
+  protected = [for user in data.openaiadmin_users_list.all.users : user.id]
+}
+`, email, role)
+}
+
+func testAccDeletePendingInvites(t *testing.T, client openai.Client, email string) {
+	ctx := context.Background()
+
+	invites, err := client.Invites.List(ctx)
+	require.NoError(t, err)
+	for _, invite := range invites {
+		if invite.Email == email && invite.Status == openai.InviteStatusPending {
+			require.NoError(t, client.Invites.Delete(ctx, invite.ID))
+		}
+	}
+}
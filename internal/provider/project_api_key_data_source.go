@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -94,73 +95,79 @@ func (r *ProjectAPIKeyDataSource) Schema(
 				MarkdownDescription: "The timestamp when the API key was created.",
 				Computed:            true,
 			},
-			"owner": schema.SingleNestedAttribute{
-				MarkdownDescription: "The owner of the project API key.",
+			"owner": projectAPIKeyOwnerSchema(),
+		},
+	}
+}
+
+// projectAPIKeyOwnerSchema describes the owner of a project API key, shared by the project API key data sources.
+func projectAPIKeyOwnerSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The owner of the project API key.",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the owner, either 'user' or 'service_account'.",
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("user", "service_account"),
+				},
+			},
+			"service_account": schema.SingleNestedAttribute{
+				Computed: true,
 				Attributes: map[string]schema.Attribute{
-					"type": schema.StringAttribute{
-						MarkdownDescription: "The type of the owner, either 'user' or 'service_account'.",
+					"id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the service account.",
 						Computed:            true,
-						Validators: []validator.String{
-							stringvalidator.OneOf("user", "service_account"),
-						},
 					},
-					"service_account": schema.SingleNestedAttribute{
-						Computed: true,
-						Attributes: map[string]schema.Attribute{
-							"id": schema.StringAttribute{
-								MarkdownDescription: "The ID of the service account.",
-								Computed:            true,
-							},
-							"name": schema.StringAttribute{
-								MarkdownDescription: "The name of the service account.",
-								Computed:            true,
-							},
-							"created_at": schema.StringAttribute{
-								CustomType:          timetypes.RFC3339Type{},
-								MarkdownDescription: "The timestamp when the service account was created.",
-								Computed:            true,
-							},
-							"role": schema.StringAttribute{
-								MarkdownDescription: "The role of the service account.",
-								Computed:            true,
-								Validators: []validator.String{stringvalidator.OneOf(
-									string(openai.ProjectServiceAccountRoleMember),
-									string(openai.ProjectServiceAccountRoleOwner),
-								)},
-							},
-						},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the service account.",
+						Computed:            true,
 					},
-					"user": schema.SingleNestedAttribute{
-						Computed: true,
-						Attributes: map[string]schema.Attribute{
-							"id": schema.StringAttribute{
-								MarkdownDescription: "The ID of the user.",
-								Computed:            true,
-							},
-							"name": schema.StringAttribute{
-								MarkdownDescription: "The name of the user.",
-								Computed:            true,
-								Optional:            true,
-							},
-							"email": schema.StringAttribute{
-								MarkdownDescription: "The email of the user.",
-								Computed:            true,
-							},
-							"created_at": schema.StringAttribute{
-								CustomType:          timetypes.RFC3339Type{},
-								MarkdownDescription: "The timestamp when the user was created.",
-								Computed:            true,
-							},
-							"role": schema.StringAttribute{
-								MarkdownDescription: "The role of the user.",
-								Computed:            true,
-								Validators: []validator.String{stringvalidator.OneOf(
-									string(openai.UserRoleReader),
-									string(openai.UserRoleOwner),
-								)},
-							},
-						},
+					"created_at": schema.StringAttribute{
+						CustomType:          timetypes.RFC3339Type{},
+						MarkdownDescription: "The timestamp when the service account was created.",
+						Computed:            true,
+					},
+					"role": schema.StringAttribute{
+						MarkdownDescription: "The role of the service account.",
+						Computed:            true,
+						Validators: []validator.String{stringvalidator.OneOf(
+							string(openai.ProjectServiceAccountRoleMember),
+							string(openai.ProjectServiceAccountRoleOwner),
+							string(openai.ProjectServiceAccountRoleAdmin),
+						)},
+					},
+				},
+			},
+			"user": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the user.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the user.",
+						Computed:            true,
+						Optional:            true,
+					},
+					"email": schema.StringAttribute{
+						MarkdownDescription: "The email of the user.",
+						Computed:            true,
+					},
+					"created_at": schema.StringAttribute{
+						CustomType:          timetypes.RFC3339Type{},
+						MarkdownDescription: "The timestamp when the user was created.",
+						Computed:            true,
+					},
+					"role": schema.StringAttribute{
+						MarkdownDescription: "The role of the user.",
+						Computed:            true,
+						Validators: []validator.String{stringvalidator.OneOf(
+							string(openai.UserRoleReader),
+							string(openai.UserRoleOwner),
+						)},
 					},
 				},
 			},
@@ -210,6 +217,28 @@ func (r *ProjectAPIKeyDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
+	resp.Diagnostics.Append(setProjectAPIKeyModel(&data, apiKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Log the data source retrieval
+	tflog.Trace(
+		ctx,
+		"Retrieved project api key",
+		map[string]any{
+			"id":         data.ID.ValueString(),
+			"project_id": data.ProjectID.ValueString(),
+		},
+	)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setProjectAPIKeyModel copies the attributes of the API key, including its owner, into the model.
+func setProjectAPIKeyModel(data *ProjectAPIKeyModel, apiKey *openai.ProjectAPIKey) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	data.Name = types.StringPointerValue(apiKey.Name)
 	data.RedactedValue = types.StringValue(apiKey.RedactedValue)
 	data.CreatedAt = timetypes.NewRFC3339TimeValue(apiKey.CreatedAt.Time)
@@ -218,11 +247,11 @@ func (r *ProjectAPIKeyDataSource) Read(ctx context.Context, req datasource.ReadR
 	case "user":
 		data.Owner.Type = types.StringValue("user")
 		if apiKey.Owner.User == nil {
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"Error reading project API key",
 				"API key owner is of type 'user' but user data is missing",
 			)
-			return
+			return diags
 		}
 		data.Owner.ServiceAccount = nil
 		data.Owner.User = &ProjectAPIKeyOwnerUserModel{
@@ -235,11 +264,11 @@ func (r *ProjectAPIKeyDataSource) Read(ctx context.Context, req datasource.ReadR
 	case "service_account":
 		data.Owner.Type = types.StringValue("service_account")
 		if apiKey.Owner.ServiceAccount == nil {
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"Error reading project API key",
 				"API key owner is of type 'service_account' but service account data is missing",
 			)
-			return
+			return diags
 		}
 		data.Owner.User = nil
 		data.Owner.ServiceAccount = &ProjectAPIKeyOwnerServiceAccountModel{
@@ -250,15 +279,5 @@ func (r *ProjectAPIKeyDataSource) Read(ctx context.Context, req datasource.ReadR
 		}
 	}
 
-	// Log the data source retrieval
-	tflog.Trace(
-		ctx,
-		"Retrieved project api key",
-		map[string]any{
-			"id":         data.ID.ValueString(),
-			"project_id": data.ProjectID.ValueString(),
-		},
-	)
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return diags
 }
@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectAPIKeyResource{}
+var _ resource.ResourceWithImportState = &ProjectAPIKeyResource{}
+
+type ProjectAPIKeyResource struct {
+	client openai.Client
+}
+
+func NewProjectAPIKeyResource() resource.Resource {
+	return &ProjectAPIKeyResource{}
+}
+
+func (r *ProjectAPIKeyResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_project_api_key"
+}
+
+func (r *ProjectAPIKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Project API Key resource. Project API keys cannot be created through the API, " +
+			"so this resource adopts an existing key and revokes it when it is destroyed.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project API key.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the project API key.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"redacted_value": schema.StringAttribute{
+				MarkdownDescription: "The redacted value of the project API key.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "The timestamp when the API key was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner": schema.SingleNestedAttribute{
+				MarkdownDescription: "The owner of the project API key.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the owner, either 'user' or 'service_account'.",
+						Computed:            true,
+					},
+					"service_account": schema.SingleNestedAttribute{
+						MarkdownDescription: "The service account that owns the API key.",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								MarkdownDescription: "The ID of the service account.",
+								Computed:            true,
+							},
+							"name": schema.StringAttribute{
+								MarkdownDescription: "The name of the service account.",
+								Computed:            true,
+							},
+							"created_at": schema.StringAttribute{
+								CustomType:          timetypes.RFC3339Type{},
+								MarkdownDescription: "The timestamp when the service account was created.",
+								Computed:            true,
+							},
+							"role": schema.StringAttribute{
+								MarkdownDescription: "The role of the service account.",
+								Computed:            true,
+							},
+						},
+					},
+					"user": schema.SingleNestedAttribute{
+						MarkdownDescription: "The user who owns the API key.",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								MarkdownDescription: "The ID of the user.",
+								Computed:            true,
+							},
+							"name": schema.StringAttribute{
+								MarkdownDescription: "The name of the user.",
+								Computed:            true,
+							},
+							"email": schema.StringAttribute{
+								MarkdownDescription: "The email of the user.",
+								Computed:            true,
+							},
+							"created_at": schema.StringAttribute{
+								CustomType:          timetypes.RFC3339Type{},
+								MarkdownDescription: "The timestamp when the user was created.",
+								Computed:            true,
+							},
+							"role": schema.StringAttribute{
+								MarkdownDescription: "The role of the user.",
+								Computed:            true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectAPIKeyResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectAPIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectAPIKeyModel
+
+	// The computed attributes are unknown during creation, so only read the identifying ones.
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_id"), &data.ProjectID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &data.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKey, err := r.client.ProjectAPIKeys.Retrieve(ctx, data.ProjectID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		if openai.IsNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Project API Key not found",
+				fmt.Sprintf("No project API key found with ID %s.", data.ID.ValueString()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading project API key", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setProjectAPIKeyModel(&data, apiKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Adopted a Project API Key resource", map[string]any{
+		"id":         data.ID.ValueString(),
+		"project_id": data.ProjectID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectAPIKeyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKey, err := r.client.ProjectAPIKeys.Retrieve(ctx, data.ProjectID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		if openai.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading project API key", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setProjectAPIKeyModel(&data, apiKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectAPIKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectAPIKeyModel
+
+	// Every configurable attribute requires replacement, so there is nothing to send to the API.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectAPIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectAPIKeyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ProjectAPIKeys.Delete(ctx, data.ProjectID.ValueString(), data.ID.ValueString())
+	if err != nil && !openai.IsNotFoundError(err) {
+		resp.Diagnostics.AddError("Error deleting project API key", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ProjectAPIKeyResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// Split the ID into project_id and key_id
+	idParts := strings.SplitN(req.ID, "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid ID format",
+			"Expected import ID to be in format: project_id/key_id",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
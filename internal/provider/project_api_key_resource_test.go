@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccProjectAPIKeyResource(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	projectName := generateTestProject()
+	serviceAccountName := generateTestServiceAccount()
+	resourceName := "openaiadmin_project_api_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectAPIKeyResourceConfig(projectName, serviceAccountName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "openaiadmin_project.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "id",
+						"openaiadmin_project_service_account.test", "api_key.id",
+					),
+					resource.TestMatchResourceAttr(resourceName, "redacted_value", regexp.MustCompile(`^sk-svcac`)),
+					resource.TestCheckResourceAttr(resourceName, "owner.type", "service_account"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "owner.service_account.id",
+						"openaiadmin_project_service_account.test", "id",
+					),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("resource not found in state: %s", resourceName)
+					}
+					return rs.Primary.Attributes["project_id"] + "/" + rs.Primary.Attributes["id"], nil
+				},
+			},
+		},
+	})
+}
+
+func testAccProjectAPIKeyResourceConfig(projectName, serviceAccountName string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_project" "test" {
+  name = %[1]q
+}
+
+resource "openaiadmin_project_service_account" "test" {
+  project_id = openaiadmin_project.test.id
+  name       = %[2]q
+}
+
+resource "openaiadmin_project_api_key" "test" {
+  project_id = openaiadmin_project.test.id
+  id         = openaiadmin_project_service_account.test.api_key.id
+}
+`, projectName, serviceAccountName)
+}
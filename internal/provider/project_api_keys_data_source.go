@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+var _ datasource.DataSource = &ProjectAPIKeysDataSource{}
+
+func NewProjectAPIKeysDataSource() datasource.DataSource {
+	return &ProjectAPIKeysDataSource{}
+}
+
+type ProjectAPIKeysDataSource struct {
+	client openai.Client
+}
+
+type ProjectAPIKeysDataSourceModel struct {
+	ProjectID     types.String         `tfsdk:"project_id"`
+	OwnerType     types.String         `tfsdk:"owner_type"`
+	OwnerID       types.String         `tfsdk:"owner_id"`
+	OwnerEmail    types.String         `tfsdk:"owner_email"`
+	NameRegex     types.String         `tfsdk:"name_regex"`
+	CreatedAfter  timetypes.RFC3339    `tfsdk:"created_after"`
+	CreatedBefore timetypes.RFC3339    `tfsdk:"created_before"`
+	APIKeys       []ProjectAPIKeyModel `tfsdk:"api_keys"`
+}
+
+func (r *ProjectAPIKeysDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_project_api_keys"
+}
+
+func (r *ProjectAPIKeysDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the API keys of a project, optionally filtered.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+			},
+			"owner_type": schema.StringAttribute{
+				MarkdownDescription: "Only return the API keys owned by this type of owner, either 'user' or 'service_account'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("user", "service_account"),
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				MarkdownDescription: "Only return the API keys owned by the user or service account with this ID.",
+				Optional:            true,
+			},
+			"owner_email": schema.StringAttribute{
+				MarkdownDescription: "Only return the API keys owned by the user with this email.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return the API keys whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"created_after": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "Only return the API keys created at or after this time.",
+				Optional:            true,
+			},
+			"created_before": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "Only return the API keys created before this time.",
+				Optional:            true,
+			},
+			"api_keys": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching project API keys.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"project_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the project.",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the project API key.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the project API key.",
+							Computed:            true,
+						},
+						"redacted_value": schema.StringAttribute{
+							MarkdownDescription: "The redacted value of the project API key.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "The timestamp when the API key was created.",
+							Computed:            true,
+						},
+						"owner": projectAPIKeyOwnerSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectAPIKeysDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected openai.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectAPIKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectAPIKeysDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := newListFilter(data.NameRegex, data.CreatedAfter, data.CreatedBefore, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKeys, err := r.client.ProjectAPIKeys.List(ctx, data.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading project API keys", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	data.APIKeys = make([]ProjectAPIKeyModel, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		if !data.OwnerType.IsNull() && apiKey.Owner.Type != data.OwnerType.ValueString() {
+			continue
+		}
+		if !data.OwnerID.IsNull() && projectAPIKeyOwnerID(apiKey.Owner) != data.OwnerID.ValueString() {
+			continue
+		}
+		if !data.OwnerEmail.IsNull() &&
+			(apiKey.Owner.User == nil || !strings.EqualFold(apiKey.Owner.User.Email, data.OwnerEmail.ValueString())) {
+			continue
+		}
+		if !filter.match(apiKey.Name, apiKey.CreatedAt.Time) {
+			continue
+		}
+
+		item := ProjectAPIKeyModel{
+			ProjectID: data.ProjectID,
+			ID:        types.StringValue(apiKey.ID),
+		}
+		resp.Diagnostics.Append(setProjectAPIKeyModel(&item, &apiKey)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.APIKeys = append(data.APIKeys, item)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// projectAPIKeyOwnerID returns the ID of the user or service account that owns an API key.
+func projectAPIKeyOwnerID(owner openai.ProjectAPIKeyOwner) string {
+	switch {
+	case owner.User != nil:
+		return owner.User.ID
+	case owner.ServiceAccount != nil:
+		return owner.ServiceAccount.ID
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectAPIKeysDataSource(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	projectName := generateTestProject()
+	serviceAccountName := generateTestServiceAccount()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectAPIKeysDataSourceConfig(projectName, serviceAccountName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openaiadmin_project_api_keys.service_accounts", "api_keys.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openaiadmin_project_api_keys.service_accounts", "api_keys.0.id",
+						"openaiadmin_project_service_account.test", "api_key.id",
+					),
+					resource.TestCheckResourceAttr(
+						"data.openaiadmin_project_api_keys.service_accounts", "api_keys.0.owner.type", "service_account",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.openaiadmin_project_api_keys.service_accounts", "api_keys.0.owner.service_account.id",
+						"openaiadmin_project_service_account.test", "id",
+					),
+					// No key is owned by a user
+					resource.TestCheckResourceAttr("data.openaiadmin_project_api_keys.users", "api_keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectAPIKeysDataSourceConfig(projectName, serviceAccountName string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_project" "test" {
+  name = %[1]q
+}
+
+resource "openaiadmin_project_service_account" "test" {
+  project_id = openaiadmin_project.test.id
+  name       = %[2]q
+}
+
+data "openaiadmin_project_api_keys" "service_accounts" {
+  project_id = openaiadmin_project.test.id
+  owner_type = "service_account"
+  owner_id   = openaiadmin_project_service_account.test.id
+}
+
+data "openaiadmin_project_api_keys" "users" {
+  project_id = openaiadmin_project.test.id
+  owner_type = "user"
+
+  depends_on = [openaiadmin_project_service_account.test]
+}
+`, projectName, serviceAccountName)
+}
@@ -0,0 +1,386 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectAPIKeysExclusiveResource{}
+var _ resource.ResourceWithConfigValidators = &ProjectAPIKeysExclusiveResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectAPIKeysExclusiveResource{}
+
+type ProjectAPIKeysExclusiveResource struct {
+	client openai.Client
+}
+
+type ProjectAPIKeysExclusiveModel struct {
+	ProjectID                types.String `tfsdk:"project_id"`
+	AllowedKeyIDs            types.Set    `tfsdk:"allowed_key_ids"`
+	AllowedServiceAccountIDs types.Set    `tfsdk:"allowed_service_account_ids"`
+	UnmanagedKeyIDs          types.Set    `tfsdk:"unmanaged_key_ids"`
+	RevokedKeyIDs            types.Set    `tfsdk:"revoked_key_ids"`
+}
+
+func NewProjectAPIKeysExclusiveResource() resource.Resource {
+	return &ProjectAPIKeysExclusiveResource{}
+}
+
+func (r *ProjectAPIKeysExclusiveResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_project_api_keys_exclusive"
+}
+
+func (r *ProjectAPIKeysExclusiveResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritative allowlist of the API keys of a project. " +
+			"Every apply revokes the project API keys that are neither listed in `allowed_key_ids` " +
+			"nor owned by a service account listed in `allowed_service_account_ids`. " +
+			"Only the keys listed in `revoked_key_ids` of the plan are revoked; " +
+			"keys created after planning are left for the next apply. " +
+			"Destroying this resource only removes it from the Terraform state and does not revoke any key.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allowed_key_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the API keys to keep.",
+				Optional:            true,
+			},
+			"allowed_service_account_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the service accounts whose API keys are kept.",
+				Optional:            true,
+			},
+			"unmanaged_key_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				MarkdownDescription: "The IDs of the API keys that are not allowed and will be revoked on the next apply. " +
+					"Always empty after a successful apply.",
+				Computed: true,
+			},
+			"revoked_key_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				MarkdownDescription: "The IDs of the API keys revoked by the apply. " +
+					"Unknown in the plan when the keys cannot be listed until the apply, e.g. for a new project. " +
+					"Reset to empty by the next refresh.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *ProjectAPIKeysExclusiveResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("allowed_key_ids"),
+			path.MatchRoot("allowed_service_account_ids"),
+		),
+	}
+}
+
+func (r *ProjectAPIKeysExclusiveResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectAPIKeysExclusiveResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data ProjectAPIKeysExclusiveModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every unmanaged key is revoked by the apply.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(
+		ctx,
+		path.Root("unmanaged_key_ids"),
+		types.SetValueMust(types.StringType, nil),
+	)...)
+
+	if r.client.ProjectAPIKeys == nil || data.ProjectID.IsUnknown() ||
+		!isSetKnown(data.AllowedKeyIDs) || !isSetKnown(data.AllowedServiceAccountIDs) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(
+			ctx,
+			path.Root("revoked_key_ids"),
+			types.SetUnknown(types.StringType),
+		)...)
+		return
+	}
+
+	unmanagedKeys, diags := r.unmanagedKeys(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The apply revokes exactly the keys listed here, so that keys created after planning are never revoked unseen.
+	revokedKeyIDs := make([]string, 0, len(unmanagedKeys))
+	descriptions := make([]string, 0, len(unmanagedKeys))
+	for _, apiKey := range unmanagedKeys {
+		revokedKeyIDs = append(revokedKeyIDs, apiKey.ID)
+		descriptions = append(descriptions, fmt.Sprintf("%s (%s)", apiKey.ID, apiKey.RedactedValue))
+	}
+	revokedKeys, diags := types.SetValueFrom(ctx, types.StringType, revokedKeyIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("revoked_key_ids"), revokedKeys)...)
+
+	if len(unmanagedKeys) == 0 {
+		return
+	}
+	resp.Diagnostics.AddWarning(
+		"Project API keys will be revoked",
+		fmt.Sprintf(
+			"Applying this plan revokes the following API keys of project %s: %s",
+			data.ProjectID.ValueString(),
+			strings.Join(descriptions, ", "),
+		),
+	)
+}
+
+func (r *ProjectAPIKeysExclusiveResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data ProjectAPIKeysExclusiveModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.revokeUnmanagedKeys(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created a Project API Keys Exclusive resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectAPIKeysExclusiveResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data ProjectAPIKeysExclusiveModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKeys, err := r.client.ProjectAPIKeys.List(ctx, data.ProjectID.ValueString())
+	if err != nil {
+		if openai.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading project API keys", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	unmanagedKeys, diags := filterUnmanagedKeys(ctx, &data, apiKeys)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unmanagedKeyIDs := make([]string, 0, len(unmanagedKeys))
+	for _, apiKey := range unmanagedKeys {
+		unmanagedKeyIDs = append(unmanagedKeyIDs, apiKey.ID)
+	}
+	data.UnmanagedKeyIDs, diags = types.SetValueFrom(ctx, types.StringType, unmanagedKeyIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.RevokedKeyIDs = types.SetValueMust(types.StringType, nil)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectAPIKeysExclusiveResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data ProjectAPIKeysExclusiveModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.revokeUnmanagedKeys(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectAPIKeysExclusiveResource) Delete(
+	ctx context.Context,
+	_ resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	// Keys are only revoked while the allowlist is managed, so there is nothing to delete.
+	resp.State.RemoveResource(ctx)
+}
+
+// revokeUnmanagedKeys revokes the API keys planned in revoked_key_ids,
+// or every API key of the project that is not allowed by the model if they were not known while planning.
+func (r *ProjectAPIKeysExclusiveResource) revokeUnmanagedKeys(
+	ctx context.Context,
+	data *ProjectAPIKeysExclusiveModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.RevokedKeyIDs.IsUnknown() {
+		unmanagedKeys, d := r.unmanagedKeys(ctx, data)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		revokedKeyIDs := make([]string, 0, len(unmanagedKeys))
+		for _, apiKey := range unmanagedKeys {
+			revokedKeyIDs = append(revokedKeyIDs, apiKey.ID)
+		}
+		data.RevokedKeyIDs, d = types.SetValueFrom(ctx, types.StringType, revokedKeyIDs)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	var revokedKeyIDs []string
+	diags.Append(data.RevokedKeyIDs.ElementsAs(ctx, &revokedKeyIDs, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, apiKeyID := range revokedKeyIDs {
+		err := r.client.ProjectAPIKeys.Delete(ctx, data.ProjectID.ValueString(), apiKeyID)
+		if err != nil && !openai.IsNotFoundError(err) {
+			diags.AddError("Error deleting project API key", fmt.Sprintf("%+v", err))
+			return diags
+		}
+
+		tflog.Debug(ctx, "Revoked unmanaged project API key", map[string]any{
+			"id":         apiKeyID,
+			"project_id": data.ProjectID.ValueString(),
+		})
+	}
+
+	data.UnmanagedKeyIDs = types.SetValueMust(types.StringType, nil)
+	return diags
+}
+
+// unmanagedKeys lists the API keys of the project that are neither allowed by ID nor by their owner.
+func (r *ProjectAPIKeysExclusiveResource) unmanagedKeys(
+	ctx context.Context,
+	data *ProjectAPIKeysExclusiveModel,
+) ([]openai.ProjectAPIKey, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiKeys, err := r.client.ProjectAPIKeys.List(ctx, data.ProjectID.ValueString())
+	if err != nil {
+		diags.AddError("Error reading project API keys", fmt.Sprintf("%+v", err))
+		return nil, diags
+	}
+
+	return filterUnmanagedKeys(ctx, data, apiKeys)
+}
+
+func filterUnmanagedKeys(
+	ctx context.Context,
+	data *ProjectAPIKeysExclusiveModel,
+	apiKeys []openai.ProjectAPIKey,
+) ([]openai.ProjectAPIKey, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var allowedKeyIDs, allowedServiceAccountIDs []string
+	diags.Append(data.AllowedKeyIDs.ElementsAs(ctx, &allowedKeyIDs, false)...)
+	diags.Append(data.AllowedServiceAccountIDs.ElementsAs(ctx, &allowedServiceAccountIDs, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var unmanagedKeys []openai.ProjectAPIKey
+	for _, apiKey := range apiKeys {
+		if slices.Contains(allowedKeyIDs, apiKey.ID) {
+			continue
+		}
+		if apiKey.Owner.ServiceAccount != nil &&
+			slices.Contains(allowedServiceAccountIDs, apiKey.Owner.ServiceAccount.ID) {
+			continue
+		}
+		unmanagedKeys = append(unmanagedKeys, apiKey)
+	}
+
+	return unmanagedKeys, diags
+}
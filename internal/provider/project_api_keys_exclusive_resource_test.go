@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectAPIKeysExclusiveResource(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	projectName := generateTestProject()
+	serviceAccountName := generateTestServiceAccount()
+	resourceName := "openaiadmin_project_api_keys_exclusive.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Allow keys by owner
+			{
+				Config: testAccProjectAPIKeysExclusiveResourceConfig(
+					projectName,
+					serviceAccountName,
+					`allowed_service_account_ids = [openaiadmin_project_service_account.test.id]`,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "openaiadmin_project.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_service_account_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_key_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "revoked_key_ids.#", "0"),
+				),
+			},
+			// Allow keys by ID
+			{
+				Config: testAccProjectAPIKeysExclusiveResourceConfig(
+					projectName,
+					serviceAccountName,
+					`allowed_key_ids = [openaiadmin_project_service_account.test.api_key.id]`,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowed_key_ids.#", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "allowed_service_account_ids"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_key_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "revoked_key_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectAPIKeysExclusiveResourceConfig(projectName, serviceAccountName, allowlist string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_project" "test" {
+  name = %[1]q
+}
+
+resource "openaiadmin_project_service_account" "test" {
+  project_id = openaiadmin_project.test.id
+  name       = %[2]q
+}
+
+resource "openaiadmin_project_api_keys_exclusive" "test" {
+  project_id = openaiadmin_project.test.id
+  %[3]s
+}
+`, projectName, serviceAccountName, allowlist)
+}
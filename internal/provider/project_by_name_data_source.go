@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+type ProjectByNameDataSource struct {
+	client openai.Client
+}
+
+type ProjectByNameDataSourceModel struct {
+	Name            types.String      `tfsdk:"name"`
+	IncludeArchived types.Bool        `tfsdk:"include_archived"`
+	ID              types.String      `tfsdk:"id"`
+	Status          types.String      `tfsdk:"status"`
+	CreatedAt       timetypes.RFC3339 `tfsdk:"created_at"`
+	ArchivedAt      timetypes.RFC3339 `tfsdk:"archived_at"`
+}
+
+func NewProjectByNameDataSource() datasource.DataSource {
+	return &ProjectByNameDataSource{}
+}
+
+func (d *ProjectByNameDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_project_by_name"
+}
+
+func (d *ProjectByNameDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve details of a specific project by its exact name. " +
+			"Fails unless exactly one project has the name.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the project to search for.",
+				Required:            true,
+			},
+			"include_archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether to search archived projects as well. " +
+					"An active project takes precedence over archived projects with the same name. (Default: `false`)",
+				Optional: true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the project.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "The timestamp when the project was created.",
+				Computed:            true,
+			},
+			"archived_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "The timestamp when the project was archived.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ProjectByNameDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			))
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ProjectByNameDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectByNameDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := d.client.Projects.ListWithArchived(ctx, data.IncludeArchived.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading projects list", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	var matches, active []openai.Project
+	for _, project := range projects {
+		if project.Name != data.Name.ValueString() {
+			continue
+		}
+		matches = append(matches, project)
+		if project.Status == openai.ProjectStatusActive {
+			active = append(active, project)
+		}
+	}
+	if len(matches) > 1 && len(active) == 1 {
+		matches = active
+	}
+
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Project not found",
+			fmt.Sprintf("No project found with name: %s", data.Name.ValueString()),
+		)
+		return
+	case 1:
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, project := range matches {
+			ids = append(ids, project.ID)
+		}
+		resp.Diagnostics.AddError(
+			"Multiple projects found",
+			fmt.Sprintf(
+				"%d projects found with name %s: %s",
+				len(matches),
+				data.Name.ValueString(),
+				strings.Join(ids, ", "),
+			),
+		)
+		return
+	}
+
+	project := newProjectDataSourceModel(&matches[0])
+	data.ID = project.ID
+	data.Status = project.Status
+	data.CreatedAt = project.CreatedAt
+	data.ArchivedAt = project.ArchivedAt
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectByNameDataSource(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	projectName := generateTestProject()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectByNameDataSourceConfig(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openaiadmin_project_by_name.test", "id",
+						"openaiadmin_project.test", "id",
+					),
+					resource.TestCheckResourceAttr("data.openaiadmin_project_by_name.test", "name", projectName),
+					resource.TestCheckResourceAttr("data.openaiadmin_project_by_name.test", "status", "active"),
+					resource.TestCheckResourceAttrSet("data.openaiadmin_project_by_name.test", "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectByNameDataSource_NonExistent(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "openaiadmin_project_by_name" "test" {
+  name = %[1]q
+}
+`, generateTestProject()),
+				ExpectError: regexp.MustCompile("No project found with name"),
+			},
+		},
+	})
+}
+
+func TestAccProjectByNameDataSource_Duplicate(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	projectName := generateTestProject()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "openaiadmin_project" "first" {
+  name = %[1]q
+}
+
+resource "openaiadmin_project" "second" {
+  name = openaiadmin_project.first.name
+}
+
+data "openaiadmin_project_by_name" "test" {
+  name = openaiadmin_project.second.name
+}
+`, projectName),
+				ExpectError: regexp.MustCompile("2 projects found with name"),
+			},
+		},
+	})
+}
+
+func testAccProjectByNameDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_project" "test" {
+  name = %[1]q
+}
+
+data "openaiadmin_project_by_name" "test" {
+  name = openaiadmin_project.test.name
+}
+`, name)
+}
@@ -116,14 +116,21 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	data.Name = types.StringValue(project.Name)
-	data.Status = types.StringValue(string(project.Status))
-	data.CreatedAt = timetypes.NewRFC3339TimeValue(project.CreatedAt.Time)
+	data = newProjectDataSourceModel(project)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func newProjectDataSourceModel(project *openai.Project) ProjectDataSourceModel {
+	data := ProjectDataSourceModel{
+		ID:         types.StringValue(project.ID),
+		Name:       types.StringValue(project.Name),
+		Status:     types.StringValue(string(project.Status)),
+		CreatedAt:  timetypes.NewRFC3339TimeValue(project.CreatedAt.Time),
+		ArchivedAt: timetypes.NewRFC3339Null(),
+	}
 	if project.ArchiveAt != nil {
 		data.ArchivedAt = timetypes.NewRFC3339TimeValue(project.ArchiveAt.Time)
-	} else {
-		data.ArchivedAt = timetypes.NewRFC3339Null()
 	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return data
 }
@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectRateLimitResource{}
+var _ resource.ResourceWithImportState = &ProjectRateLimitResource{}
+
+type ProjectRateLimitResource struct {
+	client openai.Client
+}
+
+type ProjectRateLimitModel struct {
+	ID                          types.String `tfsdk:"id"`
+	ProjectID                   types.String `tfsdk:"project_id"`
+	Model                       types.String `tfsdk:"model"`
+	MaxRequestsPer1Minute       types.Int64  `tfsdk:"max_requests_per_1_minute"`
+	MaxTokensPer1Minute         types.Int64  `tfsdk:"max_tokens_per_1_minute"`
+	MaxImagesPer1Minute         types.Int64  `tfsdk:"max_images_per_1_minute"`
+	MaxAudioMegabytesPer1Minute types.Int64  `tfsdk:"max_audio_megabytes_per_1_minute"`
+	MaxRequestsPer1Day          types.Int64  `tfsdk:"max_requests_per_1_day"`
+	Batch1DayMaxInputTokens     types.Int64  `tfsdk:"batch_1_day_max_input_tokens"`
+}
+
+func NewProjectRateLimitResource() resource.Resource {
+	return &ProjectRateLimitResource{}
+}
+
+func (r *ProjectRateLimitResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_project_rate_limit"
+}
+
+func (r *ProjectRateLimitResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Project Rate Limit resource. Rate limits cannot be deleted, " +
+			"so destroying this resource only removes it from the Terraform state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the rate limit.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project to which this rate limit belongs.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "The model this rate limit applies to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_requests_per_1_minute": rateLimitValueAttribute("The maximum requests per minute."),
+			"max_tokens_per_1_minute":   rateLimitValueAttribute("The maximum tokens per minute."),
+			"max_images_per_1_minute": rateLimitValueAttribute(
+				"The maximum images per minute. Only relevant for certain models.",
+			),
+			"max_audio_megabytes_per_1_minute": rateLimitValueAttribute(
+				"The maximum audio megabytes per minute. Only relevant for certain models.",
+			),
+			"max_requests_per_1_day": rateLimitValueAttribute(
+				"The maximum requests per day. Only relevant for certain models.",
+			),
+			"batch_1_day_max_input_tokens": rateLimitValueAttribute(
+				"The maximum batch input tokens per day. Only relevant for certain models.",
+			),
+		},
+	}
+}
+
+func rateLimitValueAttribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: description + " Defaults to the current value when not set.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *ProjectRateLimitResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectRateLimitResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data ProjectRateLimitModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rateLimit, err := r.findByModel(ctx, data.ProjectID.ValueString(), data.Model.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading project rate limits", fmt.Sprintf("%+v", err))
+		return
+	}
+	if rateLimit == nil {
+		resp.Diagnostics.AddError(
+			"Project Rate Limit not found",
+			fmt.Sprintf(
+				"No rate limit found for model %s in project %s.",
+				data.Model.ValueString(),
+				data.ProjectID.ValueString(),
+			),
+		)
+		return
+	}
+
+	rateLimit, err = r.client.ProjectRateLimits.Modify(
+		ctx,
+		data.ProjectID.ValueString(),
+		rateLimit.ID,
+		projectRateLimitModifyBody(data),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error modifying project rate limit", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	setProjectRateLimitModel(&data, rateLimit)
+
+	tflog.Trace(ctx, "Created a Project Rate Limit resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectRateLimitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectRateLimitModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rateLimit, err := r.findByModel(ctx, data.ProjectID.ValueString(), data.Model.ValueString())
+	if err != nil {
+		if openai.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading project rate limits", fmt.Sprintf("%+v", err))
+		return
+	}
+	if rateLimit == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setProjectRateLimitModel(&data, rateLimit)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectRateLimitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectRateLimitModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rateLimit, err := r.client.ProjectRateLimits.Modify(
+		ctx,
+		data.ProjectID.ValueString(),
+		data.ID.ValueString(),
+		projectRateLimitModifyBody(data),
+	)
+	if err != nil {
+		if openai.IsNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Cannot update Project Rate Limit",
+				fmt.Sprintf(
+					"The rate limit %s or project %s was not found. It may have been deleted outside of Terraform.",
+					data.ID.ValueString(),
+					data.ProjectID.ValueString(),
+				),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Error modifying project rate limit", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	setProjectRateLimitModel(&data, rateLimit)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectRateLimitResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Rate limits are owned by the project and cannot be deleted, so the last applied values are kept.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ProjectRateLimitResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// Split the ID into project_id and model
+	idParts := strings.SplitN(req.ID, "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid ID format",
+			"Expected import ID to be in format: project_id/model",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("model"), idParts[1])...)
+}
+
+func (r *ProjectRateLimitResource) findByModel(
+	ctx context.Context,
+	projectID, model string,
+) (*openai.ProjectRateLimit, error) {
+	rateLimits, err := r.client.ProjectRateLimits.List(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rateLimit := range rateLimits {
+		if rateLimit.Model == model {
+			return &rateLimit, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func projectRateLimitModifyBody(data ProjectRateLimitModel) openai.ProjectRateLimitModifyBody {
+	knownValue := func(v types.Int64) *int64 {
+		if v.IsNull() || v.IsUnknown() {
+			return nil
+		}
+		return v.ValueInt64Pointer()
+	}
+
+	return openai.ProjectRateLimitModifyBody{
+		MaxRequestsPer1Minute:       knownValue(data.MaxRequestsPer1Minute),
+		MaxTokensPer1Minute:         knownValue(data.MaxTokensPer1Minute),
+		MaxImagesPer1Minute:         knownValue(data.MaxImagesPer1Minute),
+		MaxAudioMegabytesPer1Minute: knownValue(data.MaxAudioMegabytesPer1Minute),
+		MaxRequestsPer1Day:          knownValue(data.MaxRequestsPer1Day),
+		Batch1DayMaxInputTokens:     knownValue(data.Batch1DayMaxInputTokens),
+	}
+}
+
+func setProjectRateLimitModel(data *ProjectRateLimitModel, rateLimit *openai.ProjectRateLimit) {
+	data.ID = types.StringValue(rateLimit.ID)
+	data.Model = types.StringValue(rateLimit.Model)
+	data.MaxRequestsPer1Minute = types.Int64Value(rateLimit.MaxRequestsPer1Minute)
+	data.MaxTokensPer1Minute = types.Int64Value(rateLimit.MaxTokensPer1Minute)
+	data.MaxImagesPer1Minute = types.Int64PointerValue(rateLimit.MaxImagesPer1Minute)
+	data.MaxAudioMegabytesPer1Minute = types.Int64PointerValue(rateLimit.MaxAudioMegabytesPer1Minute)
+	data.MaxRequestsPer1Day = types.Int64PointerValue(rateLimit.MaxRequestsPer1Day)
+	data.Batch1DayMaxInputTokens = types.Int64PointerValue(rateLimit.Batch1DayMaxInputTokens)
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testAccRateLimitModel = "gpt-4o-mini"
+
+func TestAccProjectRateLimitResource(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	projectName := generateTestProject()
+	resourceName := "openaiadmin_project_rate_limit.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectRateLimitResourceConfig(projectName, 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "model", testAccRateLimitModel),
+					resource.TestCheckResourceAttr(resourceName, "max_requests_per_1_minute", "100"),
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "openaiadmin_project.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "max_tokens_per_1_minute"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("resource not found in state: %s", resourceName)
+					}
+					return rs.Primary.Attributes["project_id"] + "/" + rs.Primary.Attributes["model"], nil
+				},
+			},
+			// Update testing
+			{
+				Config: testAccProjectRateLimitResourceConfig(projectName, 50),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_requests_per_1_minute", "50"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectRateLimitResourceConfig(projectName string, maxRequests int) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_project" "test" {
+  name = %[1]q
+}
+
+resource "openaiadmin_project_rate_limit" "test" {
+  project_id                = openaiadmin_project.test.id
+  model                     = %[2]q
+  max_requests_per_1_minute = %[3]d
+}
+`, projectName, testAccRateLimitModel, maxRequests)
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+type ProjectRateLimitsDataSource struct {
+	client openai.Client
+}
+
+type ProjectRateLimitData struct {
+	ID                          types.String `tfsdk:"id"`
+	Model                       types.String `tfsdk:"model"`
+	MaxRequestsPer1Minute       types.Int64  `tfsdk:"max_requests_per_1_minute"`
+	MaxTokensPer1Minute         types.Int64  `tfsdk:"max_tokens_per_1_minute"`
+	MaxImagesPer1Minute         types.Int64  `tfsdk:"max_images_per_1_minute"`
+	MaxAudioMegabytesPer1Minute types.Int64  `tfsdk:"max_audio_megabytes_per_1_minute"`
+	MaxRequestsPer1Day          types.Int64  `tfsdk:"max_requests_per_1_day"`
+	Batch1DayMaxInputTokens     types.Int64  `tfsdk:"batch_1_day_max_input_tokens"`
+}
+
+type ProjectRateLimitsDataSourceModel struct {
+	ProjectID  types.String           `tfsdk:"project_id"`
+	RateLimits []ProjectRateLimitData `tfsdk:"rate_limits"`
+}
+
+func NewProjectRateLimitsDataSource() datasource.DataSource {
+	return &ProjectRateLimitsDataSource{}
+}
+
+func (d *ProjectRateLimitsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_project_rate_limits"
+}
+
+func (d *ProjectRateLimitsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve the per-model rate limits of a project.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+			},
+			"rate_limits": schema.ListNestedAttribute{
+				MarkdownDescription: "List of rate limits of the project.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"model": schema.StringAttribute{
+							Computed: true,
+						},
+						"max_requests_per_1_minute": schema.Int64Attribute{
+							Computed: true,
+						},
+						"max_tokens_per_1_minute": schema.Int64Attribute{
+							Computed: true,
+						},
+						"max_images_per_1_minute": schema.Int64Attribute{
+							Computed: true,
+						},
+						"max_audio_megabytes_per_1_minute": schema.Int64Attribute{
+							Computed: true,
+						},
+						"max_requests_per_1_day": schema.Int64Attribute{
+							Computed: true,
+						},
+						"batch_1_day_max_input_tokens": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectRateLimitsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			))
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ProjectRateLimitsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data ProjectRateLimitsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rateLimits, err := d.client.ProjectRateLimits.List(ctx, data.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading project rate limits", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	for _, rateLimit := range rateLimits {
+		data.RateLimits = append(data.RateLimits, ProjectRateLimitData{
+			ID:                          types.StringValue(rateLimit.ID),
+			Model:                       types.StringValue(rateLimit.Model),
+			MaxRequestsPer1Minute:       types.Int64Value(rateLimit.MaxRequestsPer1Minute),
+			MaxTokensPer1Minute:         types.Int64Value(rateLimit.MaxTokensPer1Minute),
+			MaxImagesPer1Minute:         types.Int64PointerValue(rateLimit.MaxImagesPer1Minute),
+			MaxAudioMegabytesPer1Minute: types.Int64PointerValue(rateLimit.MaxAudioMegabytesPer1Minute),
+			MaxRequestsPer1Day:          types.Int64PointerValue(rateLimit.MaxRequestsPer1Day),
+			Batch1DayMaxInputTokens:     types.Int64PointerValue(rateLimit.Batch1DayMaxInputTokens),
+		})
+	}
+
+	tflog.Trace(ctx, "Retrieved project rate limits", map[string]interface{}{
+		"project_id": data.ProjectID.ValueString(),
+		"count":      len(data.RateLimits),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
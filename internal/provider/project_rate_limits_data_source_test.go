@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectRateLimitsDataSource(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	projectName := generateTestProject()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectRateLimitsDataSourceConfig(projectName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openaiadmin_project_rate_limits.test", "project_id",
+						"openaiadmin_project.test", "id",
+					),
+					resource.TestCheckResourceAttrSet("data.openaiadmin_project_rate_limits.test", "rate_limits.0.id"),
+					resource.TestCheckResourceAttrSet("data.openaiadmin_project_rate_limits.test", "rate_limits.0.model"),
+					resource.TestCheckResourceAttrSet(
+						"data.openaiadmin_project_rate_limits.test",
+						"rate_limits.0.max_requests_per_1_minute",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectRateLimitsDataSourceConfig(projectName string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_project" "test" {
+  name = %[1]q
+}
+
+data "openaiadmin_project_rate_limits" "test" {
+  project_id = openaiadmin_project.test.id
+}
+`, projectName)
+}
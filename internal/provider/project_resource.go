@@ -6,13 +6,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -34,8 +37,23 @@ type ProjectModel struct {
 	Status     types.String      `tfsdk:"status"`
 	CreatedAt  timetypes.RFC3339 `tfsdk:"created_at"`
 	ArchivedAt timetypes.RFC3339 `tfsdk:"archived_at"`
+	OnDestroy  types.String      `tfsdk:"on_destroy"`
+	OnArchived types.String      `tfsdk:"on_archived"`
 }
 
+// The actions taken when a project is destroyed.
+const (
+	projectOnDestroyArchive = "archive"
+	projectOnDestroyAbandon = "abandon"
+	projectOnDestroyError   = "error"
+)
+
+// The actions taken when a project has been archived outside of Terraform.
+const (
+	projectOnArchivedRecreate = "recreate"
+	projectOnArchivedError    = "error"
+)
+
 func NewProjectResource() resource.Resource {
 	return &ProjectResource{}
 }
@@ -86,6 +104,27 @@ func (r *ProjectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"on_destroy": schema.StringAttribute{
+				MarkdownDescription: "What to do when the project is destroyed, as projects cannot be deleted. " +
+					"`archive` archives the project, `abandon` only removes it from the Terraform state, " +
+					"and `error` fails the destroy. (Default: `archive`)",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(projectOnDestroyArchive),
+				Validators: []validator.String{
+					stringvalidator.OneOf(projectOnDestroyArchive, projectOnDestroyAbandon, projectOnDestroyError),
+				},
+			},
+			"on_archived": schema.StringAttribute{
+				MarkdownDescription: "What to do when the project has been archived outside of Terraform. " +
+					"`recreate` plans a new project, and `error` fails the refresh. (Default: `recreate`)",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(projectOnArchivedRecreate),
+				Validators: []validator.String{
+					stringvalidator.OneOf(projectOnArchivedRecreate, projectOnArchivedError),
+				},
+			},
 		},
 	}
 }
@@ -152,10 +191,42 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	project, err := r.client.Projects.Retrieve(ctx, data.ID.ValueString())
 	if err != nil {
+		if openai.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Error reading project", fmt.Sprintf("%+v", err))
 		return
 	}
 
+	// Imported projects have no settings yet, so fall back to the defaults.
+	if data.OnDestroy.IsNull() {
+		data.OnDestroy = types.StringValue(projectOnDestroyArchive)
+	}
+	if data.OnArchived.IsNull() {
+		data.OnArchived = types.StringValue(projectOnArchivedRecreate)
+	}
+
+	if project.Status == openai.ProjectStatusArchived {
+		if data.OnArchived.ValueString() == projectOnArchivedError {
+			resp.Diagnostics.AddError(
+				"Project has been archived",
+				fmt.Sprintf("The project %s has been archived outside of Terraform.", project.ID),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddWarning(
+			"Project has been archived",
+			fmt.Sprintf(
+				"The project %s has been archived outside of Terraform and will be recreated.",
+				project.ID,
+			),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	data.Name = types.StringValue(project.Name)
 	data.Status = types.StringValue(string(project.Status))
 	data.CreatedAt = timetypes.NewRFC3339TimeValue(project.CreatedAt.Time)
@@ -169,13 +240,20 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ProjectModel
+	var data, state ProjectModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// on_destroy and on_archived only live in the Terraform state, so there is nothing to modify.
+	if data.Name.Equal(state.Name) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
 	project, err := r.client.Projects.Modify(ctx, data.ID.ValueString(), data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating project", fmt.Sprintf("%+v", err))
@@ -202,10 +280,25 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	err := r.client.Projects.Archive(ctx, data.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error archiving project", fmt.Sprintf("%+v", err))
+	switch data.OnDestroy.ValueString() {
+	case projectOnDestroyAbandon:
+		tflog.Debug(ctx, "Abandoning project", map[string]any{"id": data.ID.ValueString()})
+	case projectOnDestroyError:
+		resp.Diagnostics.AddError(
+			"Project cannot be destroyed",
+			fmt.Sprintf(
+				"The project %s has on_destroy set to %q. Change on_destroy to destroy the project.",
+				data.ID.ValueString(),
+				projectOnDestroyError,
+			),
+		)
 		return
+	default:
+		err := r.client.Projects.Archive(ctx, data.ID.ValueString())
+		if err != nil && !openai.IsNotFoundError(err) {
+			resp.Diagnostics.AddError("Error archiving project", fmt.Sprintf("%+v", err))
+			return
+		}
 	}
 
 	resp.State.RemoveResource(ctx)
@@ -216,5 +309,29 @@ func (r *ProjectResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	projectID := req.ID
+
+	if name, ok := strings.CutPrefix(req.ID, importPrefixName); ok {
+		projects, err := r.client.Projects.List(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading projects", fmt.Sprintf("%+v", err))
+			return
+		}
+
+		var candidates []string
+		for _, project := range projects {
+			if project.Name == name {
+				candidates = append(candidates, project.ID)
+			}
+		}
+
+		var diags diag.Diagnostics
+		projectID, diags = selectImportID("project", req.ID, candidates)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), projectID)...)
 }
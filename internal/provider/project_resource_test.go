@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -90,6 +91,80 @@ func TestAccProjectResource_import(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import by name testing
+			{
+				ResourceName:      "openaiadmin_project.test",
+				ImportState:       true,
+				ImportStateId:     "name:" + projectName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_onDestroy(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	client := openai.NewSDKClient(os.Getenv("OPENAI_ADMIN_TOKEN"), nil)
+	projectName := generateTestProject()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroy(client),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfigOnDestroy(projectName, "error"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("openaiadmin_project.test", "on_destroy", "error"),
+					resource.TestCheckResourceAttr("openaiadmin_project.test", "on_archived", "recreate"),
+				),
+			},
+			// Destroying is refused
+			{
+				Config:      testAccProjectResourceConfigOnDestroy(projectName, "error"),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Project cannot be destroyed"),
+			},
+			// Allow the project to be archived on cleanup
+			{
+				Config: testAccProjectResourceConfigOnDestroy(projectName, "archive"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("openaiadmin_project.test", "on_destroy", "archive"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_archived(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	client := openai.NewSDKClient(os.Getenv("OPENAI_ADMIN_TOKEN"), nil)
+	projectName := generateTestProject()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroy(client),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfig(projectName),
+				Check: func(s *terraform.State) error {
+					rs, ok := s.RootModule().Resources["openaiadmin_project.test"]
+					if !ok {
+						return errors.New("Project not found in state")
+					}
+					// Archive the project outside of Terraform
+					return client.Projects.Archive(context.Background(), rs.Primary.ID)
+				},
+				// The archived project is planned to be recreated
+				ExpectNonEmptyPlan: true,
+			},
 		},
 	})
 }
@@ -102,6 +177,15 @@ resource "openaiadmin_project" "test" {
 `, name)
 }
 
+func testAccProjectResourceConfigOnDestroy(name, onDestroy string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_project" "test" {
+  name       = %[1]q
+  on_destroy = %[2]q
+}
+`, name, onDestroy)
+}
+
 func testAccCheckProjectDestroy(client openai.Client) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		for _, rs := range state.RootModule().Resources {
@@ -73,6 +73,7 @@ func (d *ProjectServiceAccountDataSource) Schema(
 				Validators: []validator.String{stringvalidator.OneOf(
 					string(openai.ProjectServiceAccountRoleMember),
 					string(openai.ProjectServiceAccountRoleOwner),
+					string(openai.ProjectServiceAccountRoleAdmin),
 				)},
 			},
 		},
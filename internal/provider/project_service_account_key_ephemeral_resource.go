@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &ProjectServiceAccountKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ProjectServiceAccountKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &ProjectServiceAccountKeyEphemeralResource{}
+
+// projectServiceAccountKeyPrivateKey is the private data key that remembers which service account to delete on Close.
+const projectServiceAccountKeyPrivateKey = "service_account"
+
+type ProjectServiceAccountKeyEphemeralResource struct {
+	client openai.Client
+}
+
+type ProjectServiceAccountKeyModel struct {
+	ProjectID        types.String      `tfsdk:"project_id"`
+	Name             types.String      `tfsdk:"name"`
+	ServiceAccountID types.String      `tfsdk:"service_account_id"`
+	ID               types.String      `tfsdk:"id"`
+	Value            types.String      `tfsdk:"value"`
+	CreatedAt        timetypes.RFC3339 `tfsdk:"created_at"`
+}
+
+type projectServiceAccountKeyPrivateData struct {
+	ProjectID        string `json:"project_id"`
+	ServiceAccountID string `json:"service_account_id"`
+}
+
+func NewProjectServiceAccountKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &ProjectServiceAccountKeyEphemeralResource{}
+}
+
+func (r *ProjectServiceAccountKeyEphemeralResource) Metadata(
+	_ context.Context,
+	req ephemeral.MetadataRequest,
+	resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_project_service_account_key"
+}
+
+func (r *ProjectServiceAccountKeyEphemeralResource) Schema(
+	_ context.Context,
+	_ ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Short-lived project service account and API key that are never persisted " +
+			"in the Terraform plan or state. The service account is created when the ephemeral resource is opened " +
+			"and deleted, together with its key, when it is closed at the end of the Terraform run.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project in which the service account is created.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the service account.",
+				Required:            true,
+			},
+			"service_account_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service account.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the API key.",
+				Computed:            true,
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The actual API key value.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"created_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "The timestamp when the API key was created.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ProjectServiceAccountKeyEphemeralResource) Configure(
+	_ context.Context,
+	req ephemeral.ConfigureRequest,
+	resp *ephemeral.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectServiceAccountKeyEphemeralResource) Open(
+	ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	var data ProjectServiceAccountKeyModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceAccount, err := r.client.ProjectServiceAccounts.Create(
+		ctx,
+		data.ProjectID.ValueString(),
+		data.Name.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating project service account", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	privateData, err := json.Marshal(projectServiceAccountKeyPrivateData{
+		ProjectID:        data.ProjectID.ValueString(),
+		ServiceAccountID: serviceAccount.ID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error encoding private data", fmt.Sprintf("%+v", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, projectServiceAccountKeyPrivateKey, privateData)...)
+
+	data.ServiceAccountID = types.StringValue(serviceAccount.ID)
+	data.ID = types.StringValue(serviceAccount.APIKey.ID)
+	data.Value = types.StringValue(serviceAccount.APIKey.Value)
+	data.CreatedAt = timetypes.NewRFC3339TimeValue(serviceAccount.APIKey.CreatedAt.Time)
+
+	tflog.Trace(ctx, "Opened a Project Service Account Key ephemeral resource", map[string]any{
+		"project_id":         data.ProjectID.ValueString(),
+		"service_account_id": serviceAccount.ID,
+	})
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *ProjectServiceAccountKeyEphemeralResource) Close(
+	ctx context.Context,
+	req ephemeral.CloseRequest,
+	resp *ephemeral.CloseResponse,
+) {
+	privateData, diags := req.Private.GetKey(ctx, projectServiceAccountKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateData == nil {
+		return
+	}
+
+	var data projectServiceAccountKeyPrivateData
+	if err := json.Unmarshal(privateData, &data); err != nil {
+		resp.Diagnostics.AddError("Error decoding private data", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	err := r.client.ProjectServiceAccounts.Delete(ctx, data.ProjectID, data.ServiceAccountID)
+	if err != nil && !openai.IsNotFoundError(err) {
+		resp.Diagnostics.AddError("Error deleting project service account", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Closed a Project Service Account Key ephemeral resource", map[string]any{
+		"project_id":         data.ProjectID,
+		"service_account_id": data.ServiceAccountID,
+	})
+}
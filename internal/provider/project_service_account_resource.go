@@ -6,13 +6,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -24,6 +27,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ProjectServiceAccountResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectServiceAccountResource{}
+var _ resource.ResourceWithValidateConfig = &ProjectServiceAccountResource{}
 
 type ProjectServiceAccountResource struct {
 	client openai.Client
@@ -37,12 +42,14 @@ type ServiceAccountAPIKeyModel struct {
 }
 
 type ProjectServiceAccountModel struct {
-	ID        types.String      `tfsdk:"id"`
-	Name      types.String      `tfsdk:"name"`
-	ProjectID types.String      `tfsdk:"project_id"`
-	Role      types.String      `tfsdk:"role"`
-	CreatedAt timetypes.RFC3339 `tfsdk:"created_at"`
-	APIKey    types.Object      `tfsdk:"api_key"`
+	ID               types.String         `tfsdk:"id"`
+	Name             types.String         `tfsdk:"name"`
+	ProjectID        types.String         `tfsdk:"project_id"`
+	Role             types.String         `tfsdk:"role"`
+	CreatedAt        timetypes.RFC3339    `tfsdk:"created_at"`
+	APIKey           types.Object         `tfsdk:"api_key"`
+	RotationTriggers types.Map            `tfsdk:"rotation_triggers"`
+	RotateAfter      timetypes.GoDuration `tfsdk:"rotate_after"`
 }
 
 func NewProjectServiceAccountResource() resource.Resource {
@@ -63,12 +70,22 @@ func (r *ProjectServiceAccountResource) Schema(
 	resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Project Service Account resource",
+		MarkdownDescription: "Project Service Account resource. " +
+			"The API only issues an API key when a service account is created " +
+			"and cannot create another key for an existing service account. " +
+			"Rotating the key through `rotation_triggers` or `rotate_after` therefore replaces the whole " +
+			"service account: its ID changes, and the previous key is revoked together with the previous account. " +
+			"The previous key stops working as soon as the apply deletes the previous service account, " +
+			"so there is no overlap window for consumers to pick up the new key, " +
+			"even with `lifecycle { create_before_destroy = true }`.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the project service account.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the project service account.",
@@ -88,14 +105,25 @@ func (r *ProjectServiceAccountResource) Schema(
 				CustomType:          timetypes.RFC3339Type{},
 				MarkdownDescription: "The timestamp when the service account was created.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"role": schema.StringAttribute{
-				MarkdownDescription: "The role of the project service account.",
-				Computed:            true,
+				MarkdownDescription: "The role of the project service account, one of `member`, `owner` or `admin`. " +
+					"The API creates every service account as `member` and cannot change its role afterwards, " +
+					"so only `member` can be configured. (Default: `member`)",
+				Optional: true,
+				Computed: true,
 				Validators: []validator.String{stringvalidator.OneOf(
 					string(openai.ProjectServiceAccountRoleMember),
 					string(openai.ProjectServiceAccountRoleOwner),
+					string(openai.ProjectServiceAccountRoleAdmin),
 				)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
 			},
 			"api_key": schema.SingleNestedAttribute{
 				MarkdownDescription: "The API key for the service account, available only during creation.",
@@ -136,6 +164,21 @@ func (r *ProjectServiceAccountResource) Schema(
 					objectplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"rotation_triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				MarkdownDescription: "Arbitrary map of values that, when changed, replaces the service account " +
+					"to obtain a new API key.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotate_after": schema.StringAttribute{
+				CustomType: timetypes.GoDurationType{},
+				MarkdownDescription: "Replace the service account to obtain a new API key on the first apply " +
+					"after the service account has become older than this duration, e.g. `720h`.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -164,6 +207,77 @@ func (r *ProjectServiceAccountResource) Configure(
 	r.client = client
 }
 
+func (r *ProjectServiceAccountResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var role types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("role"), &role)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if role.IsNull() || role.IsUnknown() || role.ValueString() == string(openai.ProjectServiceAccountRoleMember) {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		path.Root("role"),
+		"Unsupported service account role",
+		fmt.Sprintf(
+			"The API creates every project service account with the %q role and cannot change the role afterwards, "+
+				"so role %q cannot be configured. Change the role outside of Terraform, or leave role unset.",
+			openai.ProjectServiceAccountRoleMember,
+			role.ValueString(),
+		),
+	)
+}
+
+func (r *ProjectServiceAccountResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Rotation only applies to existing service accounts that are not being destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var rotateAfter timetypes.GoDuration
+	var createdAt timetypes.RFC3339
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rotate_after"), &rotateAfter)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("created_at"), &createdAt)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if rotateAfter.IsNull() || rotateAfter.IsUnknown() || createdAt.IsNull() || createdAt.IsUnknown() {
+		return
+	}
+
+	duration, diags := rotateAfter.ValueGoDuration()
+	resp.Diagnostics.Append(diags...)
+	createdTime, diags := createdAt.ValueRFC3339Time()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if time.Now().Before(createdTime.Add(duration)) {
+		return
+	}
+
+	tflog.Debug(ctx, "Rotating the API key of the project service account", map[string]any{
+		"created_at":   createdTime,
+		"rotate_after": duration.String(),
+	})
+	// Terraform only replaces a resource when the value at a RequiresReplace path changes,
+	// so mark the creation time of the replacement as unknown.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("created_at"), timetypes.NewRFC3339Unknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("created_at"))
+}
+
 func (r *ProjectServiceAccountResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -176,7 +290,7 @@ func (r *ProjectServiceAccountResource) Create(
 		return
 	}
 
-	r.create(ctx, &data, resp.Diagnostics)
+	r.create(ctx, &data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -189,7 +303,7 @@ func (r *ProjectServiceAccountResource) Create(
 func (r *ProjectServiceAccountResource) create(
 	ctx context.Context,
 	data *ProjectServiceAccountModel,
-	diagnostics diag.Diagnostics,
+	diagnostics *diag.Diagnostics,
 ) {
 	serviceAccount, err := r.client.ProjectServiceAccounts.Create(
 		ctx,
@@ -201,6 +315,24 @@ func (r *ProjectServiceAccountResource) create(
 		return
 	}
 
+	// Do not leave behind a service account with more privileges than configured.
+	role := data.Role.ValueString()
+	if !data.Role.IsNull() && !data.Role.IsUnknown() && string(serviceAccount.Role) != role {
+		diagnostics.AddError(
+			"Error creating project service account",
+			fmt.Sprintf(
+				"Configured role %q but the service account was created with role %q.",
+				role,
+				serviceAccount.Role,
+			),
+		)
+		err = r.client.ProjectServiceAccounts.Delete(ctx, data.ProjectID.ValueString(), serviceAccount.ID)
+		if err != nil && !openai.IsNotFoundError(err) {
+			diagnostics.AddError("Error deleting project service account", fmt.Sprintf("%+v", err))
+		}
+		return
+	}
+
 	data.ID = types.StringValue(serviceAccount.ID)
 	data.CreatedAt = timetypes.NewRFC3339TimeValue(serviceAccount.CreatedAt.Time)
 	data.Role = types.StringValue(string(serviceAccount.Role))
@@ -268,18 +400,8 @@ func (r *ProjectServiceAccountResource) Update(
 		return
 	}
 
-	err := r.client.ProjectServiceAccounts.Delete(ctx, data.ProjectID.ValueString(), data.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error deleting project service account", fmt.Sprintf("%+v", err))
-		return
-	}
-
-	r.create(ctx, &data, resp.Diagnostics)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Save updated data into Terraform state
+	// Every other attribute requires replacement, so only rotate_after can change here
+	// and it does not need to be sent to the API. Save updated data into Terraform state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
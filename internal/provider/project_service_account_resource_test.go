@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -121,6 +123,135 @@ func TestAccProjectServiceAccountResource_disappears(t *testing.T) {
 	})
 }
 
+func TestAccProjectServiceAccountResource_rotation(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	projectName := generateTestProject()
+	serviceAccountName := generateTestServiceAccount()
+	resourceName := "openaiadmin_project_service_account.test"
+	var previousKeyID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectServiceAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectServiceAccountResourceRotationConfig(projectName, serviceAccountName, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_triggers.version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotate_after", "720h"),
+					resource.TestCheckResourceAttrWith(resourceName, "api_key.id", func(value string) error {
+						previousKeyID = value
+						return nil
+					}),
+				),
+			},
+			// Changing the triggers rotates the key
+			{
+				Config: testAccProjectServiceAccountResourceRotationConfig(projectName, serviceAccountName, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_triggers.version", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_key.value"),
+					resource.TestCheckResourceAttrWith(resourceName, "api_key.id", func(value string) error {
+						if value == previousKeyID {
+							return errors.Errorf("API key %s was not rotated", value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectServiceAccountResource_rotateAfter(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	projectName := generateTestProject()
+	serviceAccountName := generateTestServiceAccount()
+	resourceName := "openaiadmin_project_service_account.test"
+	var previousID, previousKeyID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectServiceAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectServiceAccountResourceRotateAfterConfig(projectName, serviceAccountName, "720h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotate_after", "720h"),
+					resource.TestCheckResourceAttrWith(resourceName, "id", func(value string) error {
+						previousID = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith(resourceName, "api_key.id", func(value string) error {
+						previousKeyID = value
+						return nil
+					}),
+				),
+			},
+			// Once rotate_after has elapsed, the service account is replaced together with its key
+			{
+				PreConfig: func() { time.Sleep(2 * time.Second) },
+				Config:    testAccProjectServiceAccountResourceRotateAfterConfig(projectName, serviceAccountName, "1s"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotate_after", "1s"),
+					resource.TestCheckResourceAttrWith(resourceName, "id", func(value string) error {
+						if value == previousID {
+							return errors.Errorf("Service account %s was not replaced", value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith(resourceName, "api_key.id", func(value string) error {
+						if value == previousKeyID {
+							return errors.Errorf("API key %s was not rotated", value)
+						}
+						return nil
+					}),
+				),
+				// The new service account is older than 1s again by the time the plan is checked.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccProjectServiceAccountResource_role(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	projectName := generateTestProject()
+	serviceAccountName := generateTestServiceAccount()
+	resourceName := "openaiadmin_project_service_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectServiceAccountDestroy,
+		Steps: []resource.TestStep{
+			// Service accounts can only be created as members
+			{
+				Config:      testAccProjectServiceAccountResourceRoleConfig(projectName, serviceAccountName, "owner"),
+				ExpectError: regexp.MustCompile("Unsupported service account role"),
+			},
+			{
+				Config: testAccProjectServiceAccountResourceRoleConfig(projectName, serviceAccountName, "member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "role", "member"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_key.value"),
+				),
+			},
+		},
+	})
+}
+
 func testAccProjectServiceAccountResourceConfig(projectId, name string) string {
 	return fmt.Sprintf(`
 resource "openaiadmin_project_service_account" "test" {
@@ -156,3 +287,53 @@ func testAccCheckProjectServiceAccountDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func testAccProjectServiceAccountResourceRotationConfig(projectName, name, version string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_project" "test" {
+  name = %[1]q
+}
+
+resource "openaiadmin_project_service_account" "test" {
+  project_id   = openaiadmin_project.test.id
+  name         = %[2]q
+  rotate_after = "720h"
+
+  rotation_triggers = {
+    version = %[3]q
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, projectName, name, version)
+}
+
+func testAccProjectServiceAccountResourceRotateAfterConfig(projectName, name, rotateAfter string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_project" "test" {
+  name = %[1]q
+}
+
+resource "openaiadmin_project_service_account" "test" {
+  project_id   = openaiadmin_project.test.id
+  name         = %[2]q
+  rotate_after = %[3]q
+}
+`, projectName, name, rotateAfter)
+}
+
+func testAccProjectServiceAccountResourceRoleConfig(projectName, name, role string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_project" "test" {
+  name = %[1]q
+}
+
+resource "openaiadmin_project_service_account" "test" {
+  project_id = openaiadmin_project.test.id
+  name       = %[2]q
+  role       = %[3]q
+}
+`, projectName, name, role)
+}
@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectServiceAccountsDataSource{}
+
+type ProjectServiceAccountsDataSource struct {
+	client openai.Client
+}
+
+type ProjectServiceAccountsDataSourceModel struct {
+	ProjectID       types.String                      `tfsdk:"project_id"`
+	Role            types.String                      `tfsdk:"role"`
+	NamePrefix      types.String                      `tfsdk:"name_prefix"`
+	NameRegex       types.String                      `tfsdk:"name_regex"`
+	CreatedAfter    timetypes.RFC3339                 `tfsdk:"created_after"`
+	CreatedBefore   timetypes.RFC3339                 `tfsdk:"created_before"`
+	IncludeAPIKeys  types.Bool                        `tfsdk:"include_api_keys"`
+	ServiceAccounts []ProjectServiceAccountsItemModel `tfsdk:"service_accounts"`
+}
+
+type ProjectServiceAccountsItemModel struct {
+	ID        types.String                        `tfsdk:"id"`
+	Name      types.String                        `tfsdk:"name"`
+	Role      types.String                        `tfsdk:"role"`
+	CreatedAt timetypes.RFC3339                   `tfsdk:"created_at"`
+	APIKeys   []ProjectServiceAccountsAPIKeyModel `tfsdk:"api_keys"`
+}
+
+type ProjectServiceAccountsAPIKeyModel struct {
+	ID            types.String      `tfsdk:"id"`
+	Name          types.String      `tfsdk:"name"`
+	RedactedValue types.String      `tfsdk:"redacted_value"`
+	CreatedAt     timetypes.RFC3339 `tfsdk:"created_at"`
+}
+
+func NewProjectServiceAccountsDataSource() datasource.DataSource {
+	return &ProjectServiceAccountsDataSource{}
+}
+
+func (d *ProjectServiceAccountsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_project_service_accounts"
+}
+
+func (d *ProjectServiceAccountsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for listing the service accounts of a project, optionally filtered. " +
+			"Use `timeadd(plantimestamp(), \"-2160h\")` as `created_before` to find service accounts older than 90 days.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Only return the service accounts with this role.",
+				Optional:            true,
+				Validators: []validator.String{stringvalidator.OneOf(
+					string(openai.ProjectServiceAccountRoleMember),
+					string(openai.ProjectServiceAccountRoleOwner),
+					string(openai.ProjectServiceAccountRoleAdmin),
+				)},
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only return the service accounts whose name starts with this prefix.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return the service accounts whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"created_after": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "Only return the service accounts created at or after this time.",
+				Optional:            true,
+			},
+			"created_before": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "Only return the service accounts created before this time.",
+				Optional:            true,
+			},
+			"include_api_keys": schema.BoolAttribute{
+				MarkdownDescription: "Whether to return the API keys of every service account. (Default: `false`)",
+				Optional:            true,
+			},
+			"service_accounts": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching service accounts.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the project service account.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the project service account.",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "The role of the project service account.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "The timestamp when the service account was created.",
+							Computed:            true,
+						},
+						"api_keys": schema.ListNestedAttribute{
+							MarkdownDescription: "The API keys of the service account. Only set when `include_api_keys` is `true`.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: "The ID of the API key.",
+										Computed:            true,
+									},
+									"name": schema.StringAttribute{
+										MarkdownDescription: "The name of the API key.",
+										Computed:            true,
+									},
+									"redacted_value": schema.StringAttribute{
+										MarkdownDescription: "The redacted value of the API key.",
+										Computed:            true,
+									},
+									"created_at": schema.StringAttribute{
+										CustomType:          timetypes.RFC3339Type{},
+										MarkdownDescription: "The timestamp when the API key was created.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectServiceAccountsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ProjectServiceAccountsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data ProjectServiceAccountsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := newListFilter(data.NameRegex, data.CreatedAfter, data.CreatedBefore, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceAccounts, err := d.client.ProjectServiceAccounts.List(ctx, data.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading project service accounts", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	// The API keys of all service accounts are listed at once and grouped by their owner.
+	var apiKeysByOwner map[string][]ProjectServiceAccountsAPIKeyModel
+	if data.IncludeAPIKeys.ValueBool() {
+		apiKeys, err := d.client.ProjectAPIKeys.List(ctx, data.ProjectID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading project API keys", fmt.Sprintf("%+v", err))
+			return
+		}
+
+		apiKeysByOwner = make(map[string][]ProjectServiceAccountsAPIKeyModel)
+		for _, apiKey := range apiKeys {
+			if apiKey.Owner.ServiceAccount == nil {
+				continue
+			}
+			ownerID := apiKey.Owner.ServiceAccount.ID
+			apiKeysByOwner[ownerID] = append(apiKeysByOwner[ownerID], ProjectServiceAccountsAPIKeyModel{
+				ID:            types.StringValue(apiKey.ID),
+				Name:          types.StringPointerValue(apiKey.Name),
+				RedactedValue: types.StringValue(apiKey.RedactedValue),
+				CreatedAt:     timetypes.NewRFC3339TimeValue(apiKey.CreatedAt.Time),
+			})
+		}
+	}
+
+	data.ServiceAccounts = make([]ProjectServiceAccountsItemModel, 0, len(serviceAccounts))
+	for _, serviceAccount := range serviceAccounts {
+		if !data.Role.IsNull() && string(serviceAccount.Role) != data.Role.ValueString() {
+			continue
+		}
+		if !strings.HasPrefix(serviceAccount.Name, data.NamePrefix.ValueString()) {
+			continue
+		}
+		if !filter.match(&serviceAccount.Name, serviceAccount.CreatedAt.Time) {
+			continue
+		}
+
+		item := ProjectServiceAccountsItemModel{
+			ID:        types.StringValue(serviceAccount.ID),
+			Name:      types.StringValue(serviceAccount.Name),
+			Role:      types.StringValue(string(serviceAccount.Role)),
+			CreatedAt: timetypes.NewRFC3339TimeValue(serviceAccount.CreatedAt.Time),
+		}
+		if apiKeysByOwner != nil {
+			item.APIKeys = append([]ProjectServiceAccountsAPIKeyModel{}, apiKeysByOwner[serviceAccount.ID]...)
+		}
+		data.ServiceAccounts = append(data.ServiceAccounts, item)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
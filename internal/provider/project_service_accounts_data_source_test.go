@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectServiceAccountsDataSource(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	projectName := generateTestProject()
+	serviceAccountName := generateTestServiceAccount()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectServiceAccountsDataSourceConfig(projectName, serviceAccountName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openaiadmin_project_service_accounts.all", "service_accounts.#", "2"),
+					resource.TestCheckResourceAttr("data.openaiadmin_project_service_accounts.all", "service_accounts.0.api_keys.#", "1"),
+					// Filtered by role and name
+					resource.TestCheckResourceAttr("data.openaiadmin_project_service_accounts.owners", "service_accounts.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openaiadmin_project_service_accounts.owners", "service_accounts.0.id",
+						"openaiadmin_project_service_account.owner", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.openaiadmin_project_service_accounts.owners", "service_accounts.0.api_keys.0.id",
+						"openaiadmin_project_service_account.owner", "api_key.id",
+					),
+					resource.TestCheckResourceAttr("data.openaiadmin_project_service_accounts.prefix", "service_accounts.#", "1"),
+					resource.TestCheckNoResourceAttr("data.openaiadmin_project_service_accounts.prefix", "service_accounts.0.api_keys"),
+					// No service account is older than its project
+					resource.TestCheckResourceAttr("data.openaiadmin_project_service_accounts.old", "service_accounts.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectServiceAccountsDataSourceConfig(projectName, serviceAccountName string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_project" "test" {
+  name = %[1]q
+}
+
+resource "openaiadmin_project_service_account" "member" {
+  project_id = openaiadmin_project.test.id
+  name       = "member-%[2]s"
+  role       = "member"
+}
+
+resource "openaiadmin_project_service_account" "owner" {
+  project_id = openaiadmin_project.test.id
+  name       = "owner-%[2]s"
+  role       = "owner"
+}
+
+data "openaiadmin_project_service_accounts" "all" {
+  project_id       = openaiadmin_project.test.id
+  include_api_keys = true
+
+  depends_on = [
+    openaiadmin_project_service_account.member,
+    openaiadmin_project_service_account.owner,
+  ]
+}
+
+data "openaiadmin_project_service_accounts" "owners" {
+  project_id       = openaiadmin_project.test.id
+  role             = "owner"
+  name_regex       = "^owner-"
+  include_api_keys = true
+
+  depends_on = [openaiadmin_project_service_account.owner]
+}
+
+data "openaiadmin_project_service_accounts" "prefix" {
+  project_id  = openaiadmin_project.test.id
+  name_prefix = "member-"
+
+  depends_on = [openaiadmin_project_service_account.member]
+}
+
+data "openaiadmin_project_service_accounts" "old" {
+  project_id     = openaiadmin_project.test.id
+  created_before = openaiadmin_project.test.created_at
+
+  depends_on = [
+    openaiadmin_project_service_account.member,
+    openaiadmin_project_service_account.owner,
+  ]
+}
+`, projectName, serviceAccountName)
+}
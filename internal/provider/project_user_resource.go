@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -24,6 +25,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ProjectUserResource{}
 var _ resource.ResourceWithImportState = &ProjectUserResource{}
+var _ resource.ResourceWithConfigValidators = &ProjectUserResource{}
 
 type ProjectUserResource struct {
 	client openai.Client
@@ -65,8 +67,26 @@ func (r *ProjectUserResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:            true,
 			},
 			"email": schema.StringAttribute{
-				MarkdownDescription: "The email of the project user.",
-				Computed:            true,
+				MarkdownDescription: "The email of the user to be added to the project. " +
+					"Resolved to the user when applying, so the user must have joined the organization by then. " +
+					"Exactly one of `user_id` or `email` must be set.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIf(
+						func(
+							_ context.Context,
+							req planmodifier.StringRequest,
+							resp *stringplanmodifier.RequiresReplaceIfFuncResponse,
+						) {
+							// Emails are case-insensitive, so only a different address replaces the project user.
+							resp.RequiresReplace = !strings.EqualFold(req.StateValue.ValueString(), req.PlanValue.ValueString())
+						},
+						"Changing the email replaces the project user.",
+						"Changing the email replaces the project user.",
+					),
+				},
 			},
 			"project_id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the project to which this user belongs.",
@@ -76,10 +96,13 @@ func (r *ProjectUserResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			"user_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the user to be added to the project.",
-				Required:            true,
+				MarkdownDescription: "The ID of the user to be added to the project. " +
+					"Exactly one of `user_id` or `email` must be set.",
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"role": schema.StringAttribute{
@@ -98,6 +121,15 @@ func (r *ProjectUserResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (r *ProjectUserResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("user_id"),
+			path.MatchRoot("email"),
+		),
+	}
+}
+
 func (r *ProjectUserResource) Configure(
 	_ context.Context,
 	req resource.ConfigureRequest,
@@ -130,6 +162,26 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	if data.UserID.IsNull() || data.UserID.IsUnknown() {
+		userID, ok, err := findUserIDByEmail(ctx, r.client, data.Email.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading users", fmt.Sprintf("%+v", err))
+			return
+		}
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("email"),
+				"User not found",
+				fmt.Sprintf(
+					"No user with email %s belongs to the organization. The user may not have accepted the invite yet.",
+					data.Email.ValueString(),
+				),
+			)
+			return
+		}
+		data.UserID = types.StringValue(userID)
+	}
+
 	// Create project user
 	projectUser, err := r.client.ProjectUsers.Create(
 		ctx,
@@ -144,7 +196,7 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 
 	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.ProjectID.ValueString(), data.UserID.ValueString()))
 	data.Name = types.StringValue(projectUser.Name)
-	data.Email = types.StringValue(projectUser.Email)
+	setProjectUserEmail(&data, projectUser.Email)
 	data.Role = types.StringValue(string(projectUser.Role))
 	data.AddedAt = timetypes.NewRFC3339TimeValue(projectUser.AddedAt.Time)
 
@@ -161,6 +213,20 @@ func (r *ProjectUserResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	// Imported by email
+	if data.UserID.IsNull() {
+		userID, ok, err := findUserIDByEmail(ctx, r.client, data.Email.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading users", fmt.Sprintf("%+v", err))
+			return
+		}
+		if !ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		data.UserID = types.StringValue(userID)
+	}
+
 	// Retrieve project user details
 	projectUser, err := r.client.ProjectUsers.Retrieve(ctx, data.ProjectID.ValueString(), data.UserID.ValueString())
 	if err != nil {
@@ -174,7 +240,7 @@ func (r *ProjectUserResource) Read(ctx context.Context, req resource.ReadRequest
 
 	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.ProjectID.ValueString(), data.UserID.ValueString()))
 	data.Name = types.StringValue(projectUser.Name)
-	data.Email = types.StringValue(projectUser.Email)
+	setProjectUserEmail(&data, projectUser.Email)
 	data.Role = types.StringValue(string(projectUser.Role))
 	data.AddedAt = timetypes.NewRFC3339TimeValue(projectUser.AddedAt.Time)
 
@@ -214,7 +280,7 @@ func (r *ProjectUserResource) Update(ctx context.Context, req resource.UpdateReq
 
 	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.ProjectID.ValueString(), data.UserID.ValueString()))
 	data.Name = types.StringValue(projectUser.Name)
-	data.Email = types.StringValue(projectUser.Email)
+	setProjectUserEmail(&data, projectUser.Email)
 	data.Role = types.StringValue(string(projectUser.Role))
 	data.AddedAt = timetypes.NewRFC3339TimeValue(projectUser.AddedAt.Time)
 
@@ -243,17 +309,28 @@ func (r *ProjectUserResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	// Split the ID into project_id and user_id
+	// Split the ID into project_id and user_id or email
 	idParts := strings.Split(req.ID, "/")
 	if len(idParts) != 2 {
 		resp.Diagnostics.AddError(
 			"Invalid ID format",
-			"Expected import ID to be in format: project_id/user_id",
+			"Expected import ID to be in format: project_id/user_id or project_id/email",
 		)
 		return
 	}
 
 	// Set the split values into state
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), idParts[1])...)
+	if strings.Contains(idParts[1], "@") {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), idParts[1])...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), idParts[1])...)
+	}
+}
+
+// setProjectUserEmail keeps the configured spelling of the email, as emails are case-insensitive.
+func setProjectUserEmail(data *ProjectUserModel, email string) {
+	if !strings.EqualFold(data.Email.ValueString(), email) {
+		data.Email = types.StringValue(email)
+	}
 }
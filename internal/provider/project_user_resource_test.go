@@ -210,6 +210,56 @@ func TestAccProjectUserResource_disappears(t *testing.T) {
 	})
 }
 
+func TestAccProjectUserResource_email(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	ctx := context.Background()
+	client := openai.NewSDKClient(os.Getenv("OPENAI_ADMIN_TOKEN"), nil)
+	projectName := generateTestProject()
+	userID := os.Getenv("OPENAI_TEST_USER_ID")
+	resourceName := "openaiadmin_project_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectUserDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectUserResourceConfigEmail(projectName, userID, string(openai.ProjectUserRoleMember)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectUserExists(ctx, client, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_id", userID),
+					resource.TestCheckResourceAttrPair(resourceName, "email", "data.openaiadmin_user.test", "email"),
+				),
+			},
+			// Import by email testing
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", errors.Errorf("Project User not found: %s", resourceName)
+					}
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["email"]), nil
+				},
+				ImportStateVerify: true,
+			},
+			// Update testing (role change)
+			{
+				Config: testAccProjectUserResourceConfigEmail(projectName, userID, string(openai.ProjectUserRoleOwner)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "role", string(openai.ProjectUserRoleOwner)),
+					resource.TestCheckResourceAttr(resourceName, "user_id", userID),
+				),
+			},
+		},
+	})
+}
+
 func testAccProjectUserResourceConfig(projectID, userID, role string) string {
 	return fmt.Sprintf(`
 resource "openaiadmin_project_user" "test" {
@@ -220,6 +270,24 @@ resource "openaiadmin_project_user" "test" {
 `, projectID, userID, role)
 }
 
+func testAccProjectUserResourceConfigEmail(projectName, userID, role string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_project" "test" {
+  name = %[1]q
+}
+
+data "openaiadmin_user" "test" {
+  id = %[2]q
+}
+
+resource "openaiadmin_project_user" "test" {
+  project_id = openaiadmin_project.test.id
+  email      = data.openaiadmin_user.test.email
+  role       = %[3]q
+}
+`, projectName, userID, role)
+}
+
 func testAccCheckProjectUserExists(
 	ctx context.Context,
 	client openai.Client,
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+var _ datasource.DataSource = &ProjectUsersDataSource{}
+
+// ProjectUsersDataSource lists the users of a project.
+type ProjectUsersDataSource struct {
+	client openai.Client
+}
+
+type ProjectUsersDataSourceModel struct {
+	ProjectID    types.String                     `tfsdk:"project_id"`
+	Role         types.String                     `tfsdk:"role"`
+	Users        []ProjectUsersItemModel          `tfsdk:"users"`
+	UsersByEmail map[string]ProjectUsersItemModel `tfsdk:"users_by_email"`
+	UsersByID    map[string]ProjectUsersItemModel `tfsdk:"users_by_id"`
+}
+
+type ProjectUsersItemModel struct {
+	UserID  types.String      `tfsdk:"user_id"`
+	Name    types.String      `tfsdk:"name"`
+	Email   types.String      `tfsdk:"email"`
+	Role    types.String      `tfsdk:"role"`
+	AddedAt timetypes.RFC3339 `tfsdk:"added_at"`
+}
+
+func NewProjectUsersDataSource() datasource.DataSource {
+	return &ProjectUsersDataSource{}
+}
+
+func (d *ProjectUsersDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_project_users"
+}
+
+func (d *ProjectUsersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	userAttributes := map[string]schema.Attribute{
+		"user_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the user.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the user.",
+			Computed:            true,
+		},
+		"email": schema.StringAttribute{
+			MarkdownDescription: "The email of the user.",
+			Computed:            true,
+		},
+		"role": schema.StringAttribute{
+			MarkdownDescription: "The role of the user in the project.",
+			Computed:            true,
+		},
+		"added_at": schema.StringAttribute{
+			CustomType:          timetypes.RFC3339Type{},
+			MarkdownDescription: "The timestamp when the user was added to the project.",
+			Computed:            true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for listing the users of a project.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Only return the users with this role, either `member` or `owner`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(openai.ProjectUserRoleMember), string(openai.ProjectUserRoleOwner)),
+				},
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "The users of the project.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userAttributes,
+				},
+			},
+			"users_by_email": schema.MapNestedAttribute{
+				MarkdownDescription: "The users of the project, keyed by their lowercase email.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userAttributes,
+				},
+			},
+			"users_by_id": schema.MapNestedAttribute{
+				MarkdownDescription: "The users of the project, keyed by their user ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectUsersDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ProjectUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectUsersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUsers, err := d.client.ProjectUsers.List(ctx, data.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading project users", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	data.Users = []ProjectUsersItemModel{}
+	data.UsersByEmail = map[string]ProjectUsersItemModel{}
+	data.UsersByID = map[string]ProjectUsersItemModel{}
+	for _, projectUser := range projectUsers {
+		if !data.Role.IsNull() && string(projectUser.Role) != data.Role.ValueString() {
+			continue
+		}
+
+		user := ProjectUsersItemModel{
+			UserID:  types.StringValue(projectUser.ID),
+			Name:    types.StringValue(projectUser.Name),
+			Email:   types.StringValue(projectUser.Email),
+			Role:    types.StringValue(string(projectUser.Role)),
+			AddedAt: timetypes.NewRFC3339TimeValue(projectUser.AddedAt.Time),
+		}
+		data.Users = append(data.Users, user)
+		data.UsersByEmail[strings.ToLower(projectUser.Email)] = user
+		data.UsersByID[projectUser.ID] = user
+	}
+
+	tflog.Trace(ctx, "Read project users", map[string]interface{}{
+		"project_id": data.ProjectID.ValueString(),
+		"users":      len(data.Users),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectUsersDataSource(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	projectName := generateTestProject()
+	userID := os.Getenv("OPENAI_TEST_USER_ID")
+	resourceName := "data.openaiadmin_project_users.members"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectUsersDataSourceConfig(projectName, userID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "users.0.user_id", userID),
+					resource.TestCheckResourceAttr(resourceName, "users.0.role", "member"),
+					resource.TestCheckResourceAttrSet(resourceName, "users.0.email"),
+					resource.TestCheckResourceAttrSet(resourceName, "users.0.added_at"),
+					resource.TestCheckResourceAttr(resourceName, "users_by_id.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "users_by_id."+userID+".role", "member"),
+					resource.TestCheckResourceAttr(resourceName, "users_by_email.%", "1"),
+					// The member is filtered out when listing owners
+					resource.TestCheckNoResourceAttr(
+						"data.openaiadmin_project_users.owners", "users_by_id."+userID+".user_id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectUsersDataSourceConfig(projectName, userID string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_project" "test" {
+  name = %[1]q
+}
+
+resource "openaiadmin_project_user" "test" {
+  project_id = openaiadmin_project.test.id
+  user_id    = %[2]q
+  role       = "member"
+}
+
+data "openaiadmin_project_users" "members" {
+  project_id = openaiadmin_project.test.id
+  role       = "member"
+
+  depends_on = [openaiadmin_project_user.test]
+}
+
+data "openaiadmin_project_users" "owners" {
+  project_id = openaiadmin_project.test.id
+  role       = "owner"
+
+  depends_on = [openaiadmin_project_user.test]
+}
+`, projectName, userID)
+}
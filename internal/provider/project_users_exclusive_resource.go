@@ -0,0 +1,375 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectUsersExclusiveResource{}
+var _ resource.ResourceWithImportState = &ProjectUsersExclusiveResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectUsersExclusiveResource{}
+
+type ProjectUsersExclusiveResource struct {
+	client openai.Client
+}
+
+type ProjectUsersExclusiveModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	Members   types.Map    `tfsdk:"members"`
+	Users     types.Map    `tfsdk:"users"`
+}
+
+func NewProjectUsersExclusiveResource() resource.Resource {
+	return &ProjectUsersExclusiveResource{}
+}
+
+func (r *ProjectUsersExclusiveResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_project_users_exclusive"
+}
+
+func (r *ProjectUsersExclusiveResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritative membership of the users of a project. " +
+			"Every apply adds the missing `members`, updates their roles " +
+			"and removes the users of the project that are not listed. " +
+			"Destroying this resource only removes it from the Terraform state and does not remove any user.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.MapAttribute{
+				ElementType: types.StringType,
+				MarkdownDescription: "The role of every user of the project, keyed by user ID or email. " +
+					"Users referenced by email must already belong to the organization.",
+				Required: true,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(
+						stringvalidator.OneOf(string(openai.ProjectUserRoleMember), string(openai.ProjectUserRoleOwner)),
+					),
+				},
+			},
+			"users": schema.MapAttribute{
+				ElementType: types.StringType,
+				MarkdownDescription: "The role of every user of the project, keyed by user ID. " +
+					"Users that are not listed in `members` show up as removals in the plan.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *ProjectUsersExclusiveResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectUsersExclusiveResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data ProjectUsersExclusiveModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.Users == nil || data.ProjectID.IsUnknown() || !isMapKnown(data.Members) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(
+			ctx,
+			path.Root("users"),
+			types.MapUnknown(types.StringType),
+		)...)
+		return
+	}
+
+	// After the apply, the users of the project are exactly the resolved members.
+	members, diags := r.resolveMembers(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, diags := types.MapValueFrom(ctx, types.StringType, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("users"), users)...)
+}
+
+func (r *ProjectUsersExclusiveResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data ProjectUsersExclusiveModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created a Project Users Exclusive resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectUsersExclusiveResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data ProjectUsersExclusiveModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUsers, err := r.client.ProjectUsers.List(ctx, data.ProjectID.ValueString())
+	if err != nil {
+		if openai.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading project users", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	users := make(map[string]string, len(projectUsers))
+	for _, projectUser := range projectUsers {
+		users[projectUser.ID] = string(projectUser.Role)
+	}
+
+	var diags diag.Diagnostics
+	data.Users, diags = types.MapValueFrom(ctx, types.StringType, users)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported resources manage the current users of the project.
+	if data.Members.IsNull() {
+		data.Members = data.Users
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectUsersExclusiveResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data ProjectUsersExclusiveModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectUsersExclusiveResource) Delete(
+	ctx context.Context,
+	_ resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	// Users are only removed while the membership is managed, so there is nothing to delete.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ProjectUsersExclusiveResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("project_id"), req, resp)
+}
+
+// reconcile adds, modifies and removes the users of the project until they match the members of the model.
+func (r *ProjectUsersExclusiveResource) reconcile(
+	ctx context.Context,
+	data *ProjectUsersExclusiveModel,
+) diag.Diagnostics {
+	projectID := data.ProjectID.ValueString()
+
+	members, diags := r.resolveMembers(ctx, data)
+	if diags.HasError() {
+		return diags
+	}
+
+	projectUsers, err := r.client.ProjectUsers.List(ctx, projectID)
+	if err != nil {
+		diags.AddError("Error reading project users", fmt.Sprintf("%+v", err))
+		return diags
+	}
+
+	current := make(map[string]openai.ProjectUserRole, len(projectUsers))
+	for _, projectUser := range projectUsers {
+		current[projectUser.ID] = projectUser.Role
+	}
+
+	for userID, role := range members {
+		currentRole, ok := current[userID]
+		switch {
+		case !ok:
+			if _, err := r.client.ProjectUsers.Create(ctx, projectID, userID, openai.ProjectUserRole(role)); err != nil {
+				diags.AddError("Error creating project user", fmt.Sprintf("%+v", err))
+				return diags
+			}
+		case currentRole != openai.ProjectUserRole(role):
+			if _, err := r.client.ProjectUsers.Modify(ctx, projectID, userID, openai.ProjectUserRole(role)); err != nil {
+				diags.AddError("Error updating project user", fmt.Sprintf("%+v", err))
+				return diags
+			}
+		}
+	}
+
+	for userID := range current {
+		if _, ok := members[userID]; ok {
+			continue
+		}
+
+		err := r.client.ProjectUsers.Delete(ctx, projectID, userID)
+		if err != nil && !openai.IsNotFoundError(err) {
+			diags.AddError("Error deleting project user", fmt.Sprintf("%+v", err))
+			return diags
+		}
+
+		tflog.Debug(ctx, "Removed unmanaged project user", map[string]any{
+			"id":         userID,
+			"project_id": projectID,
+		})
+	}
+
+	users, d := types.MapValueFrom(ctx, types.StringType, members)
+	diags.Append(d...)
+	data.Users = users
+
+	return diags
+}
+
+// resolveMembers returns the role of every member keyed by user ID, looking up the members referenced by email.
+func (r *ProjectUsersExclusiveResource) resolveMembers(
+	ctx context.Context,
+	data *ProjectUsersExclusiveModel,
+) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var members map[string]string
+	diags.Append(data.Members.ElementsAs(ctx, &members, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// The organization users are listed once, the first time a member is referenced by email.
+	var users []openai.User
+	listed := false
+	resolved := make(map[string]string, len(members))
+	for key, role := range members {
+		userID := key
+		if strings.Contains(key, "@") {
+			if !listed {
+				var err error
+				users, err = r.client.Users.List(ctx)
+				if err != nil {
+					diags.AddError("Error reading users", fmt.Sprintf("%+v", err))
+					return nil, diags
+				}
+				listed = true
+			}
+
+			var found bool
+			userID, found = userIDByEmail(users, key)
+			if !found {
+				diags.AddAttributeError(
+					path.Root("members").AtMapKey(key),
+					"User not found",
+					fmt.Sprintf("No user with email %s belongs to the organization.", key),
+				)
+				continue
+			}
+		}
+
+		if _, ok := resolved[userID]; ok {
+			diags.AddAttributeError(
+				path.Root("members").AtMapKey(key),
+				"Duplicate member",
+				fmt.Sprintf("User %s is listed more than once.", userID),
+			)
+			continue
+		}
+		resolved[userID] = role
+	}
+
+	return resolved, diags
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pkg/errors"
+)
+
+func TestAccProjectUsersExclusiveResource(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	projectName := generateTestProject()
+	userID := os.Getenv("OPENAI_TEST_USER_ID")
+	resourceName := "openaiadmin_project_users_exclusive.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectUsersExclusiveResourceConfig(projectName, userID, "member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "openaiadmin_project.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "users.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "users."+userID, "member"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccProjectUsersExclusiveImportStateID(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "project_id",
+			},
+			// Update testing (role change)
+			{
+				Config: testAccProjectUsersExclusiveResourceConfig(projectName, userID, "owner"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "users.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "users."+userID, "owner"),
+				),
+			},
+			// Removing every member empties the project
+			{
+				Config: testAccProjectUsersExclusiveResourceConfigEmpty(projectName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "users.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectUsersExclusiveImportStateID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", errors.Errorf("Resource not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["project_id"], nil
+	}
+}
+
+func testAccProjectUsersExclusiveResourceConfig(projectName, userID, role string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_project" "test" {
+  name = %[1]q
+}
+
+resource "openaiadmin_project_users_exclusive" "test" {
+  project_id = openaiadmin_project.test.id
+
+  members = {
+    %[2]q = %[3]q
+  }
+}
+`, projectName, userID, role)
+}
+
+func testAccProjectUsersExclusiveResourceConfigEmpty(projectName string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_project" "test" {
+  name = %[1]q
+}
+
+resource "openaiadmin_project_users_exclusive" "test" {
+  project_id = openaiadmin_project.test.id
+  members    = {}
+}
+`, projectName)
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectsDataSource{}
+
+type ProjectsDataSource struct {
+	client openai.Client
+}
+
+type ProjectsDataSourceModel struct {
+	NameRegex       types.String             `tfsdk:"name_regex"`
+	Status          types.String             `tfsdk:"status"`
+	IncludeArchived types.Bool               `tfsdk:"include_archived"`
+	CreatedAfter    timetypes.RFC3339        `tfsdk:"created_after"`
+	CreatedBefore   timetypes.RFC3339        `tfsdk:"created_before"`
+	Projects        []ProjectDataSourceModel `tfsdk:"projects"`
+}
+
+func NewProjectsDataSource() datasource.DataSource {
+	return &ProjectsDataSource{}
+}
+
+func (d *ProjectsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (d *ProjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve the projects of the organization, optionally filtered.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return the projects whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return the projects with this status. " +
+					"Filtering by `archived` implies `include_archived`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(openai.ProjectStatusActive), string(openai.ProjectStatusArchived)),
+				},
+			},
+			"include_archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether to return archived projects. (Default: `false`)",
+				Optional:            true,
+			},
+			"created_after": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "Only return the projects created at or after this time.",
+				Optional:            true,
+			},
+			"created_before": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "Only return the projects created before this time.",
+				Optional:            true,
+			},
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching projects.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the project.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the project.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the project.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "The timestamp when the project was created.",
+							Computed:            true,
+						},
+						"archived_at": schema.StringAttribute{
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "The timestamp when the project was archived.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			))
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := newListFilter(data.NameRegex, data.CreatedAfter, data.CreatedBefore, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	includeArchived := data.IncludeArchived.ValueBool() ||
+		data.Status.ValueString() == string(openai.ProjectStatusArchived)
+
+	projects, err := d.client.Projects.ListWithArchived(ctx, includeArchived)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading projects", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	data.Projects = make([]ProjectDataSourceModel, 0, len(projects))
+	for _, project := range projects {
+		if !data.Status.IsNull() && string(project.Status) != data.Status.ValueString() {
+			continue
+		}
+		if !filter.match(&project.Name, project.CreatedAt.Time) {
+			continue
+		}
+		data.Projects = append(data.Projects, newProjectDataSourceModel(&project))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectsDataSource(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	projectName := generateTestProject()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectsDataSourceConfig(projectName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openaiadmin_projects.test", "projects.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openaiadmin_projects.test", "projects.0.id",
+						"openaiadmin_project.test", "id",
+					),
+					resource.TestCheckResourceAttr("data.openaiadmin_projects.test", "projects.0.name", projectName),
+					resource.TestCheckResourceAttr("data.openaiadmin_projects.test", "projects.0.status", "active"),
+					// No archived projects are listed unless requested
+					resource.TestCheckResourceAttr("data.openaiadmin_projects.archived", "projects.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectsDataSource_invalidRegex(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "openaiadmin_projects" "test" {
+  name_regex = "("
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid regular expression"),
+			},
+		},
+	})
+}
+
+func testAccProjectsDataSourceConfig(projectName string) string {
+	return fmt.Sprintf(`
+resource "openaiadmin_project" "test" {
+  name = %[1]q
+}
+
+data "openaiadmin_projects" "test" {
+  name_regex    = "^${openaiadmin_project.test.name}$"
+  created_after = openaiadmin_project.test.created_at
+}
+
+data "openaiadmin_projects" "archived" {
+  name_regex = "^${openaiadmin_project.test.name}$"
+  status     = "archived"
+}
+`, projectName)
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,6 +18,7 @@ import (
 
 // Ensure OpenAIAdminProvider satisfies various provider interfaces.
 var _ provider.Provider = &OpenAIAdminProvider{}
+var _ provider.ProviderWithEphemeralResources = &OpenAIAdminProvider{}
 
 // OpenAIAdminProvider defines the provider implementation.
 type OpenAIAdminProvider struct {
@@ -91,26 +93,59 @@ func (p *OpenAIAdminProvider) Configure(
 	client := openai.NewSDKClient(adminToken, baseURL)
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *OpenAIAdminProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAdminAPIKeyResource,
 		NewInviteResource,
+		NewOrganizationMemberResource,
+		NewOrganizationUsersExclusiveResource,
+		NewProjectAPIKeyResource,
+		NewProjectAPIKeysExclusiveResource,
+		NewProjectRateLimitResource,
 		NewProjectServiceAccountResource,
 		NewProjectUserResource,
+		NewProjectUsersExclusiveResource,
 		NewProjectResource,
 		NewUserResource,
 	}
 }
 
+func (p *OpenAIAdminProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewProjectServiceAccountKeyEphemeralResource,
+	}
+}
+
 func (p *OpenAIAdminProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAdminAPIKeysDataSource,
+		NewAPIKeyInventoryDataSource,
+		NewAuditLogsDataSource,
+		NewCostsDataSource,
 		NewInviteDataSource,
+		NewInvitesDataSource,
 		NewInvitesByEmailDataSource,
 		NewProjectAPIKeyDataSource,
+		NewProjectAPIKeysDataSource,
+		NewProjectByNameDataSource,
+		NewProjectRateLimitsDataSource,
 		NewProjectServiceAccountDataSource,
+		NewProjectServiceAccountsDataSource,
 		NewProjectUserDataSource,
+		NewProjectUsersDataSource,
 		NewProjectDataSource,
+		NewProjectsDataSource,
+		NewUsageCompletionsDataSource,
+		NewUsageEmbeddingsDataSource,
+		NewUsageModerationsDataSource,
+		NewUsageImagesDataSource,
+		NewUsageAudioSpeechesDataSource,
+		NewUsageAudioTranscriptionsDataSource,
+		NewUsageVectorStoresDataSource,
+		NewUsageCodeInterpreterSessionsDataSource,
 		NewUserDataSource,
 		NewUsersListDataSource,
 		NewUserByEmailDataSource,
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+type UsageAudioSpeechesDataSource struct {
+	client openai.Client
+}
+
+type UsageAudioSpeechesResultData struct {
+	ProjectID        types.String `tfsdk:"project_id"`
+	UserID           types.String `tfsdk:"user_id"`
+	APIKeyID         types.String `tfsdk:"api_key_id"`
+	Model            types.String `tfsdk:"model"`
+	Characters       types.Int64  `tfsdk:"characters"`
+	NumModelRequests types.Int64  `tfsdk:"num_model_requests"`
+}
+
+type UsageAudioSpeechesBucketData struct {
+	StartTime timetypes.RFC3339              `tfsdk:"start_time"`
+	EndTime   timetypes.RFC3339              `tfsdk:"end_time"`
+	Results   []UsageAudioSpeechesResultData `tfsdk:"results"`
+}
+
+type UsageAudioSpeechesDataSourceModel struct {
+	StartTime   timetypes.RFC3339              `tfsdk:"start_time"`
+	EndTime     timetypes.RFC3339              `tfsdk:"end_time"`
+	BucketWidth types.String                   `tfsdk:"bucket_width"`
+	GroupBy     []types.String                 `tfsdk:"group_by"`
+	ProjectIDs  []types.String                 `tfsdk:"project_ids"`
+	UserIDs     []types.String                 `tfsdk:"user_ids"`
+	APIKeyIDs   []types.String                 `tfsdk:"api_key_ids"`
+	Models      []types.String                 `tfsdk:"models"`
+	Buckets     []UsageAudioSpeechesBucketData `tfsdk:"buckets"`
+}
+
+func NewUsageAudioSpeechesDataSource() datasource.DataSource {
+	return &UsageAudioSpeechesDataSource{}
+}
+
+func (d *UsageAudioSpeechesDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_usage_audio_speeches"
+}
+
+func (d *UsageAudioSpeechesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	attributes := usageQueryAttributes(
+		[]string{"project_id", "user_id", "api_key_id", "model"},
+		usageModelFilterAttributes(),
+	)
+	attributes["buckets"] = usageBucketsAttribute(usageModelResultAttributes(map[string]schema.Attribute{
+		"characters": usageCountAttribute("The number of characters processed."),
+	}))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve the audio speeches usage of the organization.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *UsageAudioSpeechesDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			))
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsageAudioSpeechesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data UsageAudioSpeechesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := newUsageParams(data.StartTime, data.EndTime, data.BucketWidth, data.GroupBy, &resp.Diagnostics)
+	params.ProjectIDs = stringValues(data.ProjectIDs)
+	params.UserIDs = stringValues(data.UserIDs)
+	params.APIKeyIDs = stringValues(data.APIKeyIDs)
+	params.Models = stringValues(data.Models)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	buckets, err := d.client.Usage.AudioSpeeches(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading audio speeches usage", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	data.Buckets = make([]UsageAudioSpeechesBucketData, 0, len(buckets))
+	for _, bucket := range buckets {
+		results := make([]UsageAudioSpeechesResultData, 0, len(bucket.Results))
+		for _, result := range bucket.Results {
+			results = append(results, UsageAudioSpeechesResultData{
+				ProjectID:        types.StringPointerValue(result.ProjectID),
+				UserID:           types.StringPointerValue(result.UserID),
+				APIKeyID:         types.StringPointerValue(result.APIKeyID),
+				Model:            types.StringPointerValue(result.Model),
+				Characters:       types.Int64Value(result.Characters),
+				NumModelRequests: types.Int64Value(result.NumModelRequests),
+			})
+		}
+
+		data.Buckets = append(data.Buckets, UsageAudioSpeechesBucketData{
+			StartTime: timetypes.NewRFC3339TimeValue(bucket.StartTime.Time),
+			EndTime:   timetypes.NewRFC3339TimeValue(bucket.EndTime.Time),
+			Results:   results,
+		})
+	}
+
+	tflog.Trace(ctx, "Retrieved audio speeches usage", map[string]interface{}{
+		"buckets": len(data.Buckets),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
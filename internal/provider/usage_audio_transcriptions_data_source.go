@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+type UsageAudioTranscriptionsDataSource struct {
+	client openai.Client
+}
+
+type UsageAudioTranscriptionsResultData struct {
+	ProjectID        types.String `tfsdk:"project_id"`
+	UserID           types.String `tfsdk:"user_id"`
+	APIKeyID         types.String `tfsdk:"api_key_id"`
+	Model            types.String `tfsdk:"model"`
+	Seconds          types.Int64  `tfsdk:"seconds"`
+	NumModelRequests types.Int64  `tfsdk:"num_model_requests"`
+}
+
+type UsageAudioTranscriptionsBucketData struct {
+	StartTime timetypes.RFC3339                    `tfsdk:"start_time"`
+	EndTime   timetypes.RFC3339                    `tfsdk:"end_time"`
+	Results   []UsageAudioTranscriptionsResultData `tfsdk:"results"`
+}
+
+type UsageAudioTranscriptionsDataSourceModel struct {
+	StartTime   timetypes.RFC3339                    `tfsdk:"start_time"`
+	EndTime     timetypes.RFC3339                    `tfsdk:"end_time"`
+	BucketWidth types.String                         `tfsdk:"bucket_width"`
+	GroupBy     []types.String                       `tfsdk:"group_by"`
+	ProjectIDs  []types.String                       `tfsdk:"project_ids"`
+	UserIDs     []types.String                       `tfsdk:"user_ids"`
+	APIKeyIDs   []types.String                       `tfsdk:"api_key_ids"`
+	Models      []types.String                       `tfsdk:"models"`
+	Buckets     []UsageAudioTranscriptionsBucketData `tfsdk:"buckets"`
+}
+
+func NewUsageAudioTranscriptionsDataSource() datasource.DataSource {
+	return &UsageAudioTranscriptionsDataSource{}
+}
+
+func (d *UsageAudioTranscriptionsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_usage_audio_transcriptions"
+}
+
+func (d *UsageAudioTranscriptionsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	attributes := usageQueryAttributes(
+		[]string{"project_id", "user_id", "api_key_id", "model"},
+		usageModelFilterAttributes(),
+	)
+	attributes["buckets"] = usageBucketsAttribute(usageModelResultAttributes(map[string]schema.Attribute{
+		"seconds": usageCountAttribute("The number of seconds processed."),
+	}))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve the audio transcriptions usage of the organization.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *UsageAudioTranscriptionsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			))
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsageAudioTranscriptionsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data UsageAudioTranscriptionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := newUsageParams(data.StartTime, data.EndTime, data.BucketWidth, data.GroupBy, &resp.Diagnostics)
+	params.ProjectIDs = stringValues(data.ProjectIDs)
+	params.UserIDs = stringValues(data.UserIDs)
+	params.APIKeyIDs = stringValues(data.APIKeyIDs)
+	params.Models = stringValues(data.Models)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	buckets, err := d.client.Usage.AudioTranscriptions(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading audio transcriptions usage", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	data.Buckets = make([]UsageAudioTranscriptionsBucketData, 0, len(buckets))
+	for _, bucket := range buckets {
+		results := make([]UsageAudioTranscriptionsResultData, 0, len(bucket.Results))
+		for _, result := range bucket.Results {
+			results = append(results, UsageAudioTranscriptionsResultData{
+				ProjectID:        types.StringPointerValue(result.ProjectID),
+				UserID:           types.StringPointerValue(result.UserID),
+				APIKeyID:         types.StringPointerValue(result.APIKeyID),
+				Model:            types.StringPointerValue(result.Model),
+				Seconds:          types.Int64Value(result.Seconds),
+				NumModelRequests: types.Int64Value(result.NumModelRequests),
+			})
+		}
+
+		data.Buckets = append(data.Buckets, UsageAudioTranscriptionsBucketData{
+			StartTime: timetypes.NewRFC3339TimeValue(bucket.StartTime.Time),
+			EndTime:   timetypes.NewRFC3339TimeValue(bucket.EndTime.Time),
+			Results:   results,
+		})
+	}
+
+	tflog.Trace(ctx, "Retrieved audio transcriptions usage", map[string]interface{}{
+		"buckets": len(data.Buckets),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+type UsageCodeInterpreterSessionsDataSource struct {
+	client openai.Client
+}
+
+type UsageCodeInterpreterSessionsResultData struct {
+	ProjectID   types.String `tfsdk:"project_id"`
+	NumSessions types.Int64  `tfsdk:"num_sessions"`
+}
+
+type UsageCodeInterpreterSessionsBucketData struct {
+	StartTime timetypes.RFC3339                        `tfsdk:"start_time"`
+	EndTime   timetypes.RFC3339                        `tfsdk:"end_time"`
+	Results   []UsageCodeInterpreterSessionsResultData `tfsdk:"results"`
+}
+
+type UsageCodeInterpreterSessionsDataSourceModel struct {
+	StartTime   timetypes.RFC3339                        `tfsdk:"start_time"`
+	EndTime     timetypes.RFC3339                        `tfsdk:"end_time"`
+	BucketWidth types.String                             `tfsdk:"bucket_width"`
+	GroupBy     []types.String                           `tfsdk:"group_by"`
+	ProjectIDs  []types.String                           `tfsdk:"project_ids"`
+	Buckets     []UsageCodeInterpreterSessionsBucketData `tfsdk:"buckets"`
+}
+
+func NewUsageCodeInterpreterSessionsDataSource() datasource.DataSource {
+	return &UsageCodeInterpreterSessionsDataSource{}
+}
+
+func (d *UsageCodeInterpreterSessionsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_usage_code_interpreter_sessions"
+}
+
+func (d *UsageCodeInterpreterSessionsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	attributes := usageQueryAttributes([]string{"project_id"}, map[string]schema.Attribute{
+		"project_ids": usageListFilterAttribute("Return only usage for these projects."),
+	})
+	attributes["buckets"] = usageBucketsAttribute(map[string]schema.Attribute{
+		"project_id":   usageProjectResultAttribute(),
+		"num_sessions": usageCountAttribute("The number of code interpreter sessions."),
+	})
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve the code interpreter sessions usage of the organization.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *UsageCodeInterpreterSessionsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			))
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsageCodeInterpreterSessionsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data UsageCodeInterpreterSessionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := newUsageParams(data.StartTime, data.EndTime, data.BucketWidth, data.GroupBy, &resp.Diagnostics)
+	params.ProjectIDs = stringValues(data.ProjectIDs)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	buckets, err := d.client.Usage.CodeInterpreterSessions(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading code interpreter sessions usage", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	data.Buckets = make([]UsageCodeInterpreterSessionsBucketData, 0, len(buckets))
+	for _, bucket := range buckets {
+		results := make([]UsageCodeInterpreterSessionsResultData, 0, len(bucket.Results))
+		for _, result := range bucket.Results {
+			results = append(results, UsageCodeInterpreterSessionsResultData{
+				ProjectID:   types.StringPointerValue(result.ProjectID),
+				NumSessions: types.Int64Value(result.NumSessions),
+			})
+		}
+
+		data.Buckets = append(data.Buckets, UsageCodeInterpreterSessionsBucketData{
+			StartTime: timetypes.NewRFC3339TimeValue(bucket.StartTime.Time),
+			EndTime:   timetypes.NewRFC3339TimeValue(bucket.EndTime.Time),
+			Results:   results,
+		})
+	}
+
+	tflog.Trace(ctx, "Retrieved code interpreter sessions usage", map[string]interface{}{
+		"buckets": len(data.Buckets),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+type UsageCompletionsDataSource struct {
+	client openai.Client
+}
+
+type UsageCompletionsResultData struct {
+	ProjectID         types.String `tfsdk:"project_id"`
+	UserID            types.String `tfsdk:"user_id"`
+	APIKeyID          types.String `tfsdk:"api_key_id"`
+	Model             types.String `tfsdk:"model"`
+	Batch             types.Bool   `tfsdk:"batch"`
+	InputTokens       types.Int64  `tfsdk:"input_tokens"`
+	OutputTokens      types.Int64  `tfsdk:"output_tokens"`
+	InputCachedTokens types.Int64  `tfsdk:"input_cached_tokens"`
+	InputAudioTokens  types.Int64  `tfsdk:"input_audio_tokens"`
+	OutputAudioTokens types.Int64  `tfsdk:"output_audio_tokens"`
+	NumModelRequests  types.Int64  `tfsdk:"num_model_requests"`
+}
+
+type UsageCompletionsBucketData struct {
+	StartTime timetypes.RFC3339            `tfsdk:"start_time"`
+	EndTime   timetypes.RFC3339            `tfsdk:"end_time"`
+	Results   []UsageCompletionsResultData `tfsdk:"results"`
+}
+
+type UsageCompletionsDataSourceModel struct {
+	StartTime   timetypes.RFC3339            `tfsdk:"start_time"`
+	EndTime     timetypes.RFC3339            `tfsdk:"end_time"`
+	BucketWidth types.String                 `tfsdk:"bucket_width"`
+	GroupBy     []types.String               `tfsdk:"group_by"`
+	ProjectIDs  []types.String               `tfsdk:"project_ids"`
+	UserIDs     []types.String               `tfsdk:"user_ids"`
+	APIKeyIDs   []types.String               `tfsdk:"api_key_ids"`
+	Models      []types.String               `tfsdk:"models"`
+	Batch       types.Bool                   `tfsdk:"batch"`
+	Buckets     []UsageCompletionsBucketData `tfsdk:"buckets"`
+}
+
+func NewUsageCompletionsDataSource() datasource.DataSource {
+	return &UsageCompletionsDataSource{}
+}
+
+func (d *UsageCompletionsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_usage_completions"
+}
+
+func (d *UsageCompletionsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	filters := usageModelFilterAttributes()
+	filters["batch"] = schema.BoolAttribute{
+		MarkdownDescription: "If set, return only batch (`true`) or only non-batch (`false`) usage.",
+		Optional:            true,
+	}
+	attributes := usageQueryAttributes([]string{"project_id", "user_id", "api_key_id", "model", "batch"}, filters)
+	attributes["buckets"] = usageBucketsAttribute(usageModelResultAttributes(map[string]schema.Attribute{
+		"batch": schema.BoolAttribute{
+			MarkdownDescription: "Whether the usage came from the batch API. Only set when grouped by `batch`.",
+			Computed:            true,
+		},
+		"input_tokens":        usageCountAttribute("The number of input tokens used."),
+		"output_tokens":       usageCountAttribute("The number of output tokens used."),
+		"input_cached_tokens": usageCountAttribute("The number of cached input tokens used."),
+		"input_audio_tokens":  usageCountAttribute("The number of audio input tokens used."),
+		"output_audio_tokens": usageCountAttribute("The number of audio output tokens used."),
+	}))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve the completions usage of the organization.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *UsageCompletionsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			))
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsageCompletionsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data UsageCompletionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := newUsageParams(data.StartTime, data.EndTime, data.BucketWidth, data.GroupBy, &resp.Diagnostics)
+	params.ProjectIDs = stringValues(data.ProjectIDs)
+	params.UserIDs = stringValues(data.UserIDs)
+	params.APIKeyIDs = stringValues(data.APIKeyIDs)
+	params.Models = stringValues(data.Models)
+	params.Batch = data.Batch.ValueBoolPointer()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	buckets, err := d.client.Usage.Completions(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading completions usage", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	data.Buckets = make([]UsageCompletionsBucketData, 0, len(buckets))
+	for _, bucket := range buckets {
+		results := make([]UsageCompletionsResultData, 0, len(bucket.Results))
+		for _, result := range bucket.Results {
+			results = append(results, UsageCompletionsResultData{
+				ProjectID:         types.StringPointerValue(result.ProjectID),
+				UserID:            types.StringPointerValue(result.UserID),
+				APIKeyID:          types.StringPointerValue(result.APIKeyID),
+				Model:             types.StringPointerValue(result.Model),
+				Batch:             types.BoolPointerValue(result.Batch),
+				InputTokens:       types.Int64Value(result.InputTokens),
+				OutputTokens:      types.Int64Value(result.OutputTokens),
+				InputCachedTokens: types.Int64Value(result.InputCachedTokens),
+				InputAudioTokens:  types.Int64Value(result.InputAudioTokens),
+				OutputAudioTokens: types.Int64Value(result.OutputAudioTokens),
+				NumModelRequests:  types.Int64Value(result.NumModelRequests),
+			})
+		}
+
+		data.Buckets = append(data.Buckets, UsageCompletionsBucketData{
+			StartTime: timetypes.NewRFC3339TimeValue(bucket.StartTime.Time),
+			EndTime:   timetypes.NewRFC3339TimeValue(bucket.EndTime.Time),
+			Results:   results,
+		})
+	}
+
+	tflog.Trace(ctx, "Retrieved completions usage", map[string]interface{}{
+		"buckets": len(data.Buckets),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUsageCompletionsDataSource(t *testing.T) {
+	if os.Getenv("ENV") == "local" {
+		t.Parallel()
+	}
+
+	since := time.Now().Add(-7 * 24 * time.Hour).Truncate(24 * time.Hour).UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageDataSourceConfig("completions", since, `["project_id", "model"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openaiadmin_usage_completions.test", "start_time", since),
+					resource.TestCheckResourceAttr("data.openaiadmin_usage_completions.test", "bucket_width", "1d"),
+					resource.TestCheckResourceAttrSet("data.openaiadmin_usage_completions.test", "buckets.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+// usageQueryAttributes returns the time range and grouping arguments shared by every usage data source,
+// together with the given filters.
+func usageQueryAttributes(groupBy []string, filters map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"start_time": schema.StringAttribute{
+			CustomType:          timetypes.RFC3339Type{},
+			MarkdownDescription: "Start time of the query time range, inclusive.",
+			Required:            true,
+		},
+		"end_time": schema.StringAttribute{
+			CustomType:          timetypes.RFC3339Type{},
+			MarkdownDescription: "End time of the query time range, exclusive.",
+			Optional:            true,
+		},
+		"bucket_width": schema.StringAttribute{
+			MarkdownDescription: "Width of each time bucket. One of `1m`, `1h` or `1d`. (Default: `1d`)",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(openai.UsageBucketWidthMinute),
+					string(openai.UsageBucketWidthHour),
+					string(openai.UsageBucketWidthDay),
+				),
+			},
+		},
+		"group_by": schema.ListAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Group the usage data by these fields.",
+			Optional:            true,
+			Validators: []validator.List{
+				listvalidator.ValueStringsAre(stringvalidator.OneOf(groupBy...)),
+			},
+		},
+	}
+	maps.Copy(attributes, filters)
+	return attributes
+}
+
+// usageListFilterAttribute returns an optional list of strings that filters the usage.
+func usageListFilterAttribute(description string) schema.ListAttribute {
+	return schema.ListAttribute{
+		ElementType:         types.StringType,
+		MarkdownDescription: description,
+		Optional:            true,
+	}
+}
+
+// usageModelFilterAttributes returns the project, user, API key and model filters of the model usage data sources.
+func usageModelFilterAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"project_ids": usageListFilterAttribute("Return only usage for these projects."),
+		"user_ids":    usageListFilterAttribute("Return only usage for these users."),
+		"api_key_ids": usageListFilterAttribute("Return only usage for these API keys."),
+		"models":      usageListFilterAttribute("Return only usage for these models."),
+	}
+}
+
+// usageBucketsAttribute returns the computed buckets of a usage data source, whose results have the given attributes.
+func usageBucketsAttribute(results map[string]schema.Attribute) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "List of usage buckets in the time range.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"start_time": schema.StringAttribute{
+					CustomType:          timetypes.RFC3339Type{},
+					MarkdownDescription: "Start time of the bucket.",
+					Computed:            true,
+				},
+				"end_time": schema.StringAttribute{
+					CustomType:          timetypes.RFC3339Type{},
+					MarkdownDescription: "End time of the bucket.",
+					Computed:            true,
+				},
+				"results": schema.ListNestedAttribute{
+					MarkdownDescription: "Aggregated usage within the bucket.",
+					Computed:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: results,
+					},
+				},
+			},
+		},
+	}
+}
+
+// usageProjectResultAttribute returns the project_id of the usage results.
+func usageProjectResultAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "The ID of the project. Only set when grouped by `project_id`.",
+		Computed:            true,
+	}
+}
+
+// usageModelResultAttributes returns the grouping fields and the request count of the model usage results,
+// together with the given metrics.
+func usageModelResultAttributes(metrics map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"project_id": usageProjectResultAttribute(),
+		"user_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the user. Only set when grouped by `user_id`.",
+			Computed:            true,
+		},
+		"api_key_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the API key. Only set when grouped by `api_key_id`.",
+			Computed:            true,
+		},
+		"model": schema.StringAttribute{
+			MarkdownDescription: "The name of the model. Only set when grouped by `model`.",
+			Computed:            true,
+		},
+		"num_model_requests": schema.Int64Attribute{
+			MarkdownDescription: "The number of requests made to the model.",
+			Computed:            true,
+		},
+	}
+	maps.Copy(attributes, metrics)
+	return attributes
+}
+
+// usageCountAttribute returns a computed metric of the usage results.
+func usageCountAttribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: description,
+		Computed:            true,
+	}
+}
+
+// newUsageParams converts the arguments shared by every usage data source into query parameters.
+func newUsageParams(
+	startTime, endTime timetypes.RFC3339,
+	bucketWidth types.String,
+	groupBy []types.String,
+	diagnostics *diag.Diagnostics,
+) openai.UsageParams {
+	params := openai.UsageParams{
+		EndTime: optionalTime(endTime, diagnostics),
+		GroupBy: stringValues(groupBy),
+	}
+	if start := optionalTime(startTime, diagnostics); start != nil {
+		params.StartTime = *start
+	}
+	if !bucketWidth.IsNull() {
+		width := openai.UsageBucketWidth(bucketWidth.ValueString())
+		params.BucketWidth = &width
+	}
+	return params
+}
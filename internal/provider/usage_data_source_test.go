@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "fmt"
+
+func testAccUsageDataSourceConfig(kind, since, groupBy string) string {
+	return fmt.Sprintf(`
+data "openaiadmin_usage_%[1]s" "test" {
+  start_time   = %[2]q
+  bucket_width = "1d"
+  group_by     = %[3]s
+}
+`, kind, since, groupBy)
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+type UsageImagesDataSource struct {
+	client openai.Client
+}
+
+type UsageImagesResultData struct {
+	ProjectID        types.String `tfsdk:"project_id"`
+	UserID           types.String `tfsdk:"user_id"`
+	APIKeyID         types.String `tfsdk:"api_key_id"`
+	Model            types.String `tfsdk:"model"`
+	Source           types.String `tfsdk:"source"`
+	Size             types.String `tfsdk:"size"`
+	Images           types.Int64  `tfsdk:"images"`
+	NumModelRequests types.Int64  `tfsdk:"num_model_requests"`
+}
+
+type UsageImagesBucketData struct {
+	StartTime timetypes.RFC3339       `tfsdk:"start_time"`
+	EndTime   timetypes.RFC3339       `tfsdk:"end_time"`
+	Results   []UsageImagesResultData `tfsdk:"results"`
+}
+
+type UsageImagesDataSourceModel struct {
+	StartTime   timetypes.RFC3339       `tfsdk:"start_time"`
+	EndTime     timetypes.RFC3339       `tfsdk:"end_time"`
+	BucketWidth types.String            `tfsdk:"bucket_width"`
+	GroupBy     []types.String          `tfsdk:"group_by"`
+	ProjectIDs  []types.String          `tfsdk:"project_ids"`
+	UserIDs     []types.String          `tfsdk:"user_ids"`
+	APIKeyIDs   []types.String          `tfsdk:"api_key_ids"`
+	Models      []types.String          `tfsdk:"models"`
+	Sources     []types.String          `tfsdk:"sources"`
+	Sizes       []types.String          `tfsdk:"sizes"`
+	Buckets     []UsageImagesBucketData `tfsdk:"buckets"`
+}
+
+func NewUsageImagesDataSource() datasource.DataSource {
+	return &UsageImagesDataSource{}
+}
+
+func (d *UsageImagesDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_usage_images"
+}
+
+func (d *UsageImagesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	filters := usageModelFilterAttributes()
+	filters["sources"] = usageListFilterAttribute(
+		"Return only usage for these sources, e.g. `image.generation`, `image.edit` or `image.variation`.",
+	)
+	filters["sizes"] = usageListFilterAttribute("Return only usage for these image sizes, e.g. `1024x1024`.")
+	attributes := usageQueryAttributes(
+		[]string{"project_id", "user_id", "api_key_id", "model", "size", "source"},
+		filters,
+	)
+	attributes["buckets"] = usageBucketsAttribute(usageModelResultAttributes(map[string]schema.Attribute{
+		"source": schema.StringAttribute{
+			MarkdownDescription: "The source of the images. Only set when grouped by `source`.",
+			Computed:            true,
+		},
+		"size": schema.StringAttribute{
+			MarkdownDescription: "The size of the images. Only set when grouped by `size`.",
+			Computed:            true,
+		},
+		"images": usageCountAttribute("The number of images processed."),
+	}))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve the images usage of the organization.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *UsageImagesDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			))
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsageImagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsageImagesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := newUsageParams(data.StartTime, data.EndTime, data.BucketWidth, data.GroupBy, &resp.Diagnostics)
+	params.ProjectIDs = stringValues(data.ProjectIDs)
+	params.UserIDs = stringValues(data.UserIDs)
+	params.APIKeyIDs = stringValues(data.APIKeyIDs)
+	params.Models = stringValues(data.Models)
+	params.Sources = stringValues(data.Sources)
+	params.Sizes = stringValues(data.Sizes)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	buckets, err := d.client.Usage.Images(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading images usage", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	data.Buckets = make([]UsageImagesBucketData, 0, len(buckets))
+	for _, bucket := range buckets {
+		results := make([]UsageImagesResultData, 0, len(bucket.Results))
+		for _, result := range bucket.Results {
+			results = append(results, UsageImagesResultData{
+				ProjectID:        types.StringPointerValue(result.ProjectID),
+				UserID:           types.StringPointerValue(result.UserID),
+				APIKeyID:         types.StringPointerValue(result.APIKeyID),
+				Model:            types.StringPointerValue(result.Model),
+				Source:           types.StringPointerValue(result.Source),
+				Size:             types.StringPointerValue(result.Size),
+				Images:           types.Int64Value(result.Images),
+				NumModelRequests: types.Int64Value(result.NumModelRequests),
+			})
+		}
+
+		data.Buckets = append(data.Buckets, UsageImagesBucketData{
+			StartTime: timetypes.NewRFC3339TimeValue(bucket.StartTime.Time),
+			EndTime:   timetypes.NewRFC3339TimeValue(bucket.EndTime.Time),
+			Results:   results,
+		})
+	}
+
+	tflog.Trace(ctx, "Retrieved images usage", map[string]interface{}{
+		"buckets": len(data.Buckets),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
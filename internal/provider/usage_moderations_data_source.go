@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+type UsageModerationsDataSource struct {
+	client openai.Client
+}
+
+type UsageModerationsResultData struct {
+	ProjectID        types.String `tfsdk:"project_id"`
+	UserID           types.String `tfsdk:"user_id"`
+	APIKeyID         types.String `tfsdk:"api_key_id"`
+	Model            types.String `tfsdk:"model"`
+	InputTokens      types.Int64  `tfsdk:"input_tokens"`
+	NumModelRequests types.Int64  `tfsdk:"num_model_requests"`
+}
+
+type UsageModerationsBucketData struct {
+	StartTime timetypes.RFC3339            `tfsdk:"start_time"`
+	EndTime   timetypes.RFC3339            `tfsdk:"end_time"`
+	Results   []UsageModerationsResultData `tfsdk:"results"`
+}
+
+type UsageModerationsDataSourceModel struct {
+	StartTime   timetypes.RFC3339            `tfsdk:"start_time"`
+	EndTime     timetypes.RFC3339            `tfsdk:"end_time"`
+	BucketWidth types.String                 `tfsdk:"bucket_width"`
+	GroupBy     []types.String               `tfsdk:"group_by"`
+	ProjectIDs  []types.String               `tfsdk:"project_ids"`
+	UserIDs     []types.String               `tfsdk:"user_ids"`
+	APIKeyIDs   []types.String               `tfsdk:"api_key_ids"`
+	Models      []types.String               `tfsdk:"models"`
+	Buckets     []UsageModerationsBucketData `tfsdk:"buckets"`
+}
+
+func NewUsageModerationsDataSource() datasource.DataSource {
+	return &UsageModerationsDataSource{}
+}
+
+func (d *UsageModerationsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_usage_moderations"
+}
+
+func (d *UsageModerationsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	attributes := usageQueryAttributes(
+		[]string{"project_id", "user_id", "api_key_id", "model"},
+		usageModelFilterAttributes(),
+	)
+	attributes["buckets"] = usageBucketsAttribute(usageModelResultAttributes(map[string]schema.Attribute{
+		"input_tokens": usageCountAttribute("The number of input tokens used."),
+	}))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve the moderations usage of the organization.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *UsageModerationsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			))
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsageModerationsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data UsageModerationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := newUsageParams(data.StartTime, data.EndTime, data.BucketWidth, data.GroupBy, &resp.Diagnostics)
+	params.ProjectIDs = stringValues(data.ProjectIDs)
+	params.UserIDs = stringValues(data.UserIDs)
+	params.APIKeyIDs = stringValues(data.APIKeyIDs)
+	params.Models = stringValues(data.Models)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	buckets, err := d.client.Usage.Moderations(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading moderations usage", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	data.Buckets = make([]UsageModerationsBucketData, 0, len(buckets))
+	for _, bucket := range buckets {
+		results := make([]UsageModerationsResultData, 0, len(bucket.Results))
+		for _, result := range bucket.Results {
+			results = append(results, UsageModerationsResultData{
+				ProjectID:        types.StringPointerValue(result.ProjectID),
+				UserID:           types.StringPointerValue(result.UserID),
+				APIKeyID:         types.StringPointerValue(result.APIKeyID),
+				Model:            types.StringPointerValue(result.Model),
+				InputTokens:      types.Int64Value(result.InputTokens),
+				NumModelRequests: types.Int64Value(result.NumModelRequests),
+			})
+		}
+
+		data.Buckets = append(data.Buckets, UsageModerationsBucketData{
+			StartTime: timetypes.NewRFC3339TimeValue(bucket.StartTime.Time),
+			EndTime:   timetypes.NewRFC3339TimeValue(bucket.EndTime.Time),
+			Results:   results,
+		})
+	}
+
+	tflog.Trace(ctx, "Retrieved moderations usage", map[string]interface{}{
+		"buckets": len(data.Buckets),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
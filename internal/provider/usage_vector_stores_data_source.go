@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isac322/terraform-provider-openaiadmin/internal/openai"
+)
+
+type UsageVectorStoresDataSource struct {
+	client openai.Client
+}
+
+type UsageVectorStoresResultData struct {
+	ProjectID  types.String `tfsdk:"project_id"`
+	UsageBytes types.Int64  `tfsdk:"usage_bytes"`
+}
+
+type UsageVectorStoresBucketData struct {
+	StartTime timetypes.RFC3339             `tfsdk:"start_time"`
+	EndTime   timetypes.RFC3339             `tfsdk:"end_time"`
+	Results   []UsageVectorStoresResultData `tfsdk:"results"`
+}
+
+type UsageVectorStoresDataSourceModel struct {
+	StartTime   timetypes.RFC3339             `tfsdk:"start_time"`
+	EndTime     timetypes.RFC3339             `tfsdk:"end_time"`
+	BucketWidth types.String                  `tfsdk:"bucket_width"`
+	GroupBy     []types.String                `tfsdk:"group_by"`
+	ProjectIDs  []types.String                `tfsdk:"project_ids"`
+	Buckets     []UsageVectorStoresBucketData `tfsdk:"buckets"`
+}
+
+func NewUsageVectorStoresDataSource() datasource.DataSource {
+	return &UsageVectorStoresDataSource{}
+}
+
+func (d *UsageVectorStoresDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_usage_vector_stores"
+}
+
+func (d *UsageVectorStoresDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	attributes := usageQueryAttributes([]string{"project_id"}, map[string]schema.Attribute{
+		"project_ids": usageListFilterAttribute("Return only usage for these projects."),
+	})
+	attributes["buckets"] = usageBucketsAttribute(map[string]schema.Attribute{
+		"project_id":  usageProjectResultAttribute(),
+		"usage_bytes": usageCountAttribute("The vector stores usage in bytes."),
+	})
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve the vector stores usage of the organization.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *UsageVectorStoresDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(openai.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected openai.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			))
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsageVectorStoresDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data UsageVectorStoresDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := newUsageParams(data.StartTime, data.EndTime, data.BucketWidth, data.GroupBy, &resp.Diagnostics)
+	params.ProjectIDs = stringValues(data.ProjectIDs)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	buckets, err := d.client.Usage.VectorStores(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading vector stores usage", fmt.Sprintf("%+v", err))
+		return
+	}
+
+	data.Buckets = make([]UsageVectorStoresBucketData, 0, len(buckets))
+	for _, bucket := range buckets {
+		results := make([]UsageVectorStoresResultData, 0, len(bucket.Results))
+		for _, result := range bucket.Results {
+			results = append(results, UsageVectorStoresResultData{
+				ProjectID:  types.StringPointerValue(result.ProjectID),
+				UsageBytes: types.Int64Value(result.UsageBytes),
+			})
+		}
+
+		data.Buckets = append(data.Buckets, UsageVectorStoresBucketData{
+			StartTime: timetypes.NewRFC3339TimeValue(bucket.StartTime.Time),
+			EndTime:   timetypes.NewRFC3339TimeValue(bucket.EndTime.Time),
+			Results:   results,
+		})
+	}
+
+	tflog.Trace(ctx, "Retrieved vector stores usage", map[string]interface{}{
+		"buckets": len(data.Buckets),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
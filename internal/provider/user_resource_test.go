@@ -49,6 +49,25 @@ func TestAccUserResource(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "role", string(openai.UserRoleOwner)),
 				),
 			},
+			// Import by email testing
+			{
+				Config:       testAccUserResourceConfig_withRole(string(openai.UserRoleOwner)),
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					user, err := client.Users.Retrieve(ctx, userID)
+					if err != nil {
+						return "", err
+					}
+					return "email:" + user.Email, nil
+				},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].ID != userID {
+						return errors.Errorf("expected user %s to be imported, got %v", userID, states)
+					}
+					return nil
+				},
+			},
 		},
 	})
 }